@@ -0,0 +1,279 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+var typeOfError = reflect.TypeOf((*error)(nil)).Elem()
+
+// WithAccessors option resolves the field value via getter and setter methods,
+// when the field is not exported or missing. So the encapsulated domain types
+// can be copied to and from. For field "Name", getter is `GetName() T` or
+// `Name() T` method and setter is `SetName(v T)` or `SetName(v T) error` method,
+// pointer receiver methods are supported too.
+// 		Example:
+//
+// 		type User struct {
+// 			name string
+// 		}
+//
+// 		func (u *User) Name() string { return u.name }
+// 		func (u *User) SetName(name string) { u.name = name }
+//
+// 		// dto.Name is copied via user.SetName()
+// 		errs := model.Copy(&user, dto, model.WithAccessors())
+//
+// 		// user.Name() is copied into dto.Name
+// 		errs = model.Copy(&dto, user, model.WithAccessors())
+//
+// For `Map()` method, getter value of unexported field is included in the result
+// map by accessor name, for eg.: "Name".
+//
+// Note: Accessor is used only if the field is not exported on either side, tag
+// options are not applicable to it.
+//
+func WithAccessors() Option {
+	return func(o *options) {
+		o.accessors = true
+	}
+}
+
+// accessorField is the field resolved via getter or setter method on either side.
+type accessorField struct {
+	name string
+
+	// srcIndex is index sequence of source field, nil if getter is used
+	srcIndex []int
+	getter   string
+
+	// dstIndex is index sequence of destination field, nil if setter is used
+	dstIndex []int
+	setter   string
+}
+
+// accessorCache keeps the resolved accessor fields per source and destination types
+var accessorCache sync.Map // map[planKey][]accessorField
+
+// accessorsOf method returns the fields of source and destination types which
+// are resolved via getter or setter method. Fields which exists on both side
+// are copied by plan, so those are not included.
+func accessorsOf(st, dt reflect.Type) []accessorField {
+	key := planKey{src: st, dst: dt}
+	if afs, found := accessorCache.Load(key); found {
+		return afs.([]accessorField)
+	}
+
+	// candidate names from both side
+	var names []string
+	seen := map[string]bool{}
+	addName := func(name string) {
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	for _, f := range typeInfoOf(st).fields {
+		addName(f.Name)
+	}
+	for i := 0; i < st.NumField(); i++ {
+		if f := st.Field(i); !f.IsExported() && !f.Anonymous {
+			addName(accessorName(f.Name))
+		}
+	}
+	for _, f := range typeInfoOf(dt).fields {
+		addName(f.Name)
+	}
+
+	var afs []accessorField
+	for _, name := range names {
+		af := accessorField{name: name}
+		sf, srcFound := exportedField(st, name)
+		df, dstFound := exportedField(dt, name)
+		if srcFound && dstFound {
+			continue
+		}
+
+		if srcFound {
+			af.srcIndex = sf.Index
+		} else if af.getter = getterOf(st, name); isStringEmpty(af.getter) {
+			continue
+		}
+
+		if dstFound {
+			af.dstIndex = df.Index
+		} else if af.setter = setterOf(dt, name); isStringEmpty(af.setter) {
+			continue
+		}
+
+		afs = append(afs, af)
+	}
+
+	actual, _ := accessorCache.LoadOrStore(key, afs)
+	return actual.([]accessorField)
+}
+
+// copyAccessors method copies the source fields into destination fields which
+// are resolved via getter or setter method.
+func copyAccessors(dv, sv reflect.Value, cs *copyState) []error {
+	var errs []error
+
+	afs := accessorsOf(sv.Type(), dv.Type())
+	if len(afs) == 0 {
+		return nil
+	}
+
+	sv = addressable(sv)
+	for _, af := range afs {
+		if cs.stopped(errs) {
+			break
+		}
+
+		var sfv reflect.Value
+		if af.srcIndex != nil {
+			f, err := sv.FieldByIndexErr(af.srcIndex)
+			if err != nil {
+				continue
+			}
+			sfv = f
+		} else {
+			sfv = sv.Addr().MethodByName(af.getter).Call(nil)[0]
+		}
+
+		var (
+			dfv    reflect.Value
+			setter reflect.Value
+		)
+		if af.dstIndex != nil {
+			f, err := dv.FieldByIndexErr(af.dstIndex)
+			if err != nil || !f.CanSet() {
+				continue
+			}
+			dfv = f
+		} else {
+			setter = dv.Addr().MethodByName(af.setter)
+			dfv = reflect.New(setter.Type().In(0)).Elem()
+		}
+
+		// validate field - kind and type
+		if err := validateCopyField(reflect.StructField{Name: af.name}, sfv, dfv, cs.opts); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		v, err := copyVal(dfv.Type(), sfv, cs.opts.isNoTraverseType(sfv), cs)
+		errs = append(errs, fieldErrors(af.name, sfv.Type(), dfv.Type(), err)...)
+		if !v.IsValid() {
+			continue
+		}
+
+		if !setter.IsValid() {
+			dfv.Set(v)
+			continue
+		}
+
+		// setter error is reported as field error
+		if out := setter.Call([]reflect.Value{v}); len(out) == 1 && !out[0].IsNil() {
+			err := out[0].Interface().(error)
+			errs = append(errs, newFieldError(af.name, sfv.Type(), v.Type(), err, "%v", err))
+		}
+	}
+
+	return errs
+}
+
+// mapAccessors method adds the getter values of unexported fields into given
+// map, existing keys are not overwritten.
+func mapAccessors(m map[string]interface{}, sv reflect.Value, o *options) {
+	st := sv.Type()
+	for i := 0; i < st.NumField(); i++ {
+		f := st.Field(i)
+		if f.IsExported() || f.Anonymous {
+			continue
+		}
+
+		name := accessorName(f.Name)
+		getter := getterOf(st, name)
+		if isStringEmpty(getter) {
+			continue
+		}
+
+		if _, found := m[name]; found {
+			continue
+		}
+
+		sv = addressable(sv)
+		v := sv.Addr().MethodByName(getter).Call(nil)[0]
+		o.mapping.field(name)
+		if isFieldZero(v) {
+			m[name] = reflect.Zero(v.Type()).Interface()
+		} else if isStruct(v) && !o.isNoTraverseType(v) && !isInterface(v) {
+			m[name] = doMap(v, o)
+		} else {
+			m[name] = mapVal(v, o.isNoTraverseType(v), o).Interface()
+		}
+	}
+}
+
+// getterOf method returns the getter method name of the field, it's either
+// `GetName()` or `Name()` with single return value.
+func getterOf(t reflect.Type, name string) string {
+	pt := reflect.PtrTo(t)
+	for _, mn := range []string{"Get" + name, name} {
+		if m, found := pt.MethodByName(mn); found && m.Type.NumIn() == 1 && m.Type.NumOut() == 1 {
+			return mn
+		}
+	}
+
+	return ""
+}
+
+// setterOf method returns the setter method name of the field, it's
+// `SetName(v)` with no return value or error.
+func setterOf(t reflect.Type, name string) string {
+	mn := "Set" + name
+	m, found := reflect.PtrTo(t).MethodByName(mn)
+	if !found || m.Type.NumIn() != 2 {
+		return ""
+	}
+
+	if m.Type.NumOut() == 0 || (m.Type.NumOut() == 1 && m.Type.Out(0) == typeOfError) {
+		return mn
+	}
+
+	return ""
+}
+
+// exportedField method returns the exported field by name, promoted field
+// is resolved too.
+func exportedField(t reflect.Type, name string) (reflect.StructField, bool) {
+	f, found := structField(t, name)
+	if !found || !f.IsExported() {
+		return reflect.StructField{}, false
+	}
+
+	return f, true
+}
+
+// accessorName method returns the accessor name of unexported field, for
+// eg.: "name" to "Name".
+func accessorName(name string) string {
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// addressable method returns the addressable value, the copy of value is
+// returned if it's not addressable.
+func addressable(v reflect.Value) reflect.Value {
+	if v.CanAddr() {
+		return v
+	}
+
+	av := reflect.New(v.Type()).Elem()
+	av.Set(v)
+	return av
+}
@@ -0,0 +1,95 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"errors"
+	"testing"
+)
+
+type sampleAccount struct {
+	name    string
+	balance int
+	tags    []string
+	ID      int
+}
+
+func (a *sampleAccount) Name() string        { return a.name }
+func (a *sampleAccount) SetName(name string) { a.name = name }
+func (a sampleAccount) GetBalance() int      { return a.balance }
+
+func (a *sampleAccount) SetBalance(balance int) error {
+	if balance < 0 {
+		return errors.New("balance cannot be negative")
+	}
+	a.balance = balance
+	return nil
+}
+
+func (a *sampleAccount) Tags() []string { return a.tags }
+
+func TestCopyAccessors(t *testing.T) {
+	type AccountDto struct {
+		ID      int
+		Name    string
+		Balance int
+		Tags    []string
+	}
+
+	// into encapsulated type via setters
+	dto := AccountDto{ID: 1, Name: "savings", Balance: 100, Tags: []string{"a"}}
+	account := sampleAccount{}
+	errs := Copy(&account, dto, WithAccessors())
+	assertError(t, errors.Join(errs...))
+	assertEqual(t, 1, account.ID)
+	assertEqual(t, "savings", account.name)
+	assertEqual(t, 100, account.balance)
+	assertEqual(t, true, account.tags == nil)
+
+	// without option fields are not copied
+	account = sampleAccount{}
+	errs = Copy(&account, dto)
+	assertError(t, errors.Join(errs...))
+	assertEqual(t, "", account.name)
+
+	// from encapsulated type via getters
+	account = sampleAccount{name: "current", balance: 50, tags: []string{"b"}, ID: 2}
+	dto = AccountDto{}
+	errs = Copy(&dto, account, WithAccessors())
+	assertError(t, errors.Join(errs...))
+	assertEqual(t, 2, dto.ID)
+	assertEqual(t, "current", dto.Name)
+	assertEqual(t, 50, dto.Balance)
+	assertEqual(t, []string{"b"}, dto.Tags)
+
+	// slice from getter is copied
+	dto.Tags[0] = "c"
+	assertEqual(t, "b", account.tags[0])
+
+	// setter error is reported
+	account = sampleAccount{}
+	errs = Copy(&account, AccountDto{Name: "loan", Balance: -1}, WithAccessors())
+	assertEqual(t, "Field: 'Balance', balance cannot be negative", errors.Join(errs...).Error())
+	assertEqual(t, "loan", account.name)
+
+	// type mismatch is reported
+	type BadDto struct {
+		Name int
+	}
+	errs = Copy(&account, BadDto{Name: 1}, WithAccessors())
+	assertEqual(t, "Field: 'Name', src [int] & dst [string] kind didn't match", errors.Join(errs...).Error())
+}
+
+func TestMapAccessors(t *testing.T) {
+	account := sampleAccount{name: "current", balance: 50, ID: 2}
+
+	m, err := Map(account, WithAccessors())
+	assertError(t, err)
+	assertEqual(t, map[string]interface{}{"ID": 2, "Name": "current", "Balance": 50, "Tags": []string(nil)}, m)
+
+	m, err = Map(account)
+	assertError(t, err)
+	assertEqual(t, map[string]interface{}{"ID": 2}, m)
+}
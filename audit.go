@@ -0,0 +1,117 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"reflect"
+	"sort"
+)
+
+// AuditEntry is a change made on destination field by `CopyWithAudit()` method.
+type AuditEntry struct {
+	// Path is the field path expression of destination, see `Get()` method
+	Path string `json:"path"`
+
+	// Before is the field value prior to copy
+	Before interface{} `json:"before"`
+
+	// After is the field value after copy
+	After interface{} `json:"after"`
+
+	// Source is the type of source and it's field path the value came from
+	Source string `json:"source"`
+}
+
+// AuditLog is the list of changes made by `CopyWithAudit()` method, in the
+// sorted order of path. It's serializable to JSON.
+type AuditLog []AuditEntry
+
+// CopyWithAudit method copies the source `struct` into destination `struct` same as
+// `Copy()` method and returns the `AuditLog` of every changed destination field
+// with before and after values, which can be used for compliance logging.
+// 		Example:
+//
+// 		auditLog, errs := model.CopyWithAudit(&user, userUpdateRequest)
+// 		if errs != nil {
+// 			fmt.Println("Errors:", errs)
+// 		}
+//
+// 		b, _ := json.Marshal(auditLog)
+// 		fmt.Println(string(b))
+//
+// 		// Output:
+// 		[{"path":"Email","before":"old@myjeeva.com","after":"new@myjeeva.com","source":"dto.UserUpdateRequest.Email"}]
+//
+// Note: Slice, map and interface field values are compared as a whole.
+//
+func CopyWithAudit(dst, src interface{}) (AuditLog, []error) {
+	before, err := snapshot(dst)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	errs := Copy(dst, src)
+
+	after, err := snapshot(dst)
+	if err != nil {
+		return nil, append(errs, err)
+	}
+
+	// union of field paths
+	paths := make([]string, 0, len(after))
+	for path := range after {
+		paths = append(paths, path)
+	}
+	for path := range before {
+		if _, found := after[path]; !found {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	srcType := indirect(valueOf(src)).Type().String()
+
+	var auditLog AuditLog
+	for _, path := range paths {
+		bv, av := before[path], after[path]
+		if reflect.DeepEqual(bv, av) {
+			continue
+		}
+
+		source := srcType
+		if _, err := Get(src, path); err == nil {
+			source = srcType + "." + path
+		}
+
+		auditLog = append(auditLog, AuditEntry{Path: path, Before: bv, After: av, Source: source})
+	}
+
+	return auditLog, errs
+}
+
+// snapshot method captures the field values of given struct by field path,
+// nested struct are traversed and other values are captured as a whole.
+func snapshot(s interface{}) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	err := Walk(s, func(path string, f reflect.StructField, v reflect.Value) error {
+		tag := newTag(f.Tag.Get(TagName))
+		if isStruct(v) && !isInterface(v) && !isNoTraverseType(v) && !tag.isNoTraverse() {
+			if !isPtr(v) || !v.IsNil() {
+				return nil
+			}
+		}
+
+		// nil pointer is captured as nil, so it's comparable with absent path
+		if isPtr(v) && v.IsNil() {
+			values[path] = nil
+		} else {
+			values[path] = v.Interface()
+		}
+
+		return SkipTraverse
+	})
+
+	return values, err
+}
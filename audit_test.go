@@ -0,0 +1,70 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCopyWithAudit(t *testing.T) {
+	type Address struct {
+		City string `json:"city"`
+	}
+
+	type User struct {
+		Name    string
+		Email   string
+		Age     *int
+		Address *Address
+		Tags    []string
+	}
+
+	type UserUpdate struct {
+		Name    string
+		Email   string `model:",omitempty"`
+		Age     *int
+		Address *Address
+		Tags    []string
+	}
+
+	age := 30
+	dst := User{Name: "Jeeva", Email: "jeeva@myjeeva.com", Age: &age, Tags: []string{"a"}}
+
+	newAge := 31
+	src := UserUpdate{Name: "Jeevanandam", Age: &newAge, Address: &Address{City: "Chennai"}, Tags: []string{"a"}}
+
+	auditLog, errs := CopyWithAudit(&dst, src)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, 3, len(auditLog))
+
+	assertEqual(t, "Address.City", auditLog[0].Path)
+	assertEqual(t, true, auditLog[0].Before == nil)
+	assertEqual(t, "Chennai", auditLog[0].After)
+	assertEqual(t, "model.UserUpdate.Address.City", auditLog[0].Source)
+
+	assertEqual(t, "Age", auditLog[1].Path)
+	assertEqual(t, 30, *auditLog[1].Before.(*int))
+	assertEqual(t, 31, *auditLog[1].After.(*int))
+
+	assertEqual(t, "Name", auditLog[2].Path)
+	assertEqual(t, "Jeeva", auditLog[2].Before)
+	assertEqual(t, "Jeevanandam", auditLog[2].After)
+
+	b, err := json.Marshal(auditLog[2:])
+	assertError(t, err)
+	assertEqual(t, `[{"path":"Name","before":"Jeeva","after":"Jeevanandam","source":"model.UserUpdate.Name"}]`, string(b))
+
+	// nothing changed
+	auditLog, errs = CopyWithAudit(&dst, src)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, 0, len(auditLog))
+
+	_, errs = CopyWithAudit(dst, src)
+	assertEqual(t, "Destination struct is not a pointer", errs[0].Error())
+
+	_, errs = CopyWithAudit(nil, src)
+	assertEqual(t, "Invalid input <nil>", errs[0].Error())
+}
@@ -0,0 +1,121 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// AddBidirectionalConversion method registers the forward `Converter` of datatype pair
+// and the backward one for reverse direction by supplying pointers of the target types.
+// The backward converter is derived when it's nil and datatype pair is simple pointer
+// and it's value, for eg.: `string` and `*string`.
+// 		Example:
+//
+// 		err := model.AddBidirectionalConversion((*time.Time)(nil), (*int64)(nil),
+// 			func(in reflect.Value) (reflect.Value, error) {
+// 				return reflect.ValueOf(in.Interface().(time.Time).Unix()), nil
+// 			},
+// 			func(in reflect.Value) (reflect.Value, error) {
+// 				return reflect.ValueOf(time.Unix(in.Int(), 0)), nil
+// 			})
+//
+// 		// backward is derived
+// 		err = model.AddBidirectionalConversion((*Money)(nil), (**Money)(nil), moneyConverter, nil)
+//
+// See also `AddRenameConversion()` method for rename-only conversion of struct types.
+//
+func AddBidirectionalConversion(a interface{}, b interface{}, forward, backward Converter) error {
+	at, bt := extractType(a), extractType(b)
+	if forward == nil {
+		return errors.New("forward converter is nil")
+	}
+
+	if backward == nil {
+		backward = deriveReverse(at, bt)
+		if backward == nil {
+			return fmt.Errorf("cannot derive reverse converter of [%v] to [%v]", at, bt)
+		}
+	}
+
+	AddConversionByType(at, bt, forward)
+	AddConversionByType(bt, at, backward)
+	return nil
+}
+
+// AddRenameConversion method registers the converters in both directions for the
+// `struct` types which differ by field names only, by supplying pointers of the
+// target types. The names maps field name of a into field name of b, fields which
+// are not in the names are converted by same name as per `Copy()` method.
+// 		Example:
+//
+// 		err := model.AddRenameConversion((*Address)(nil), (*AddressDto)(nil),
+// 			map[string]string{"Line1": "Street", "Zip": "PostalCode"})
+//
+func AddRenameConversion(a interface{}, b interface{}, names map[string]string) error {
+	at, bt := extractType(a), extractType(b)
+
+	forward, backward := NewProfile(reflect.New(at).Interface(), reflect.New(bt).Interface()),
+		NewProfile(reflect.New(bt).Interface(), reflect.New(at).Interface())
+	for aName, bName := range names {
+		forward.Field(bName).From(aName)
+		backward.Field(aName).From(bName)
+	}
+
+	if forward.err != nil {
+		return forward.err
+	}
+
+	if backward.err != nil {
+		return backward.err
+	}
+
+	AddConversionByType(at, bt, profileConverter(forward))
+	AddConversionByType(bt, at, profileConverter(backward))
+	return nil
+}
+
+// deriveReverse method returns the reverse converter of simple pointer and it's
+// value datatype pair, otherwise nil.
+func deriveReverse(at, bt reflect.Type) Converter {
+	switch {
+	case bt == reflect.PtrTo(at):
+		// reverse of pointer wrap is dereference
+		return func(in reflect.Value) (reflect.Value, error) {
+			if in.IsNil() {
+				return reflect.Zero(at), nil
+			}
+			return in.Elem(), nil
+		}
+	case at == reflect.PtrTo(bt):
+		// reverse of dereference is pointer wrap
+		return func(in reflect.Value) (reflect.Value, error) {
+			pv := reflect.New(bt)
+			pv.Elem().Set(in)
+			return pv, nil
+		}
+	}
+
+	return nil
+}
+
+// profileConverter method returns the converter which copies the struct value
+// as per profile.
+func profileConverter(p *Profile) Converter {
+	return func(in reflect.Value) (reflect.Value, error) {
+		nv := reflect.New(p.dstType)
+		if isStructZero(in) {
+			return nv.Elem(), nil
+		}
+
+		if errs := p.Copy(nv.Interface(), in.Interface()); len(errs) > 0 {
+			return reflect.Value{}, errors.Join(errs...)
+		}
+
+		return nv.Elem(), nil
+	}
+}
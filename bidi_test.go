@@ -0,0 +1,143 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAddBidirectionalConversion(t *testing.T) {
+	type Event struct {
+		CreatedAt time.Time
+	}
+
+	type EventDto struct {
+		CreatedAt int64
+	}
+
+	err := AddBidirectionalConversion((*time.Time)(nil), (*int64)(nil),
+		func(in reflect.Value) (reflect.Value, error) {
+			return reflect.ValueOf(in.Interface().(time.Time).Unix()), nil
+		},
+		func(in reflect.Value) (reflect.Value, error) {
+			return reflect.ValueOf(time.Unix(in.Int(), 0).UTC()), nil
+		})
+	assertError(t, err)
+	defer RemoveConversion((*time.Time)(nil), (*int64)(nil))
+	defer RemoveConversion((*int64)(nil), (*time.Time)(nil))
+
+	now := time.Date(2016, 8, 1, 10, 0, 0, 0, time.UTC)
+	dto := EventDto{}
+	errs := Copy(&dto, Event{CreatedAt: now})
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, now.Unix(), dto.CreatedAt)
+
+	event := Event{}
+	errs = Copy(&event, dto)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, true, event.CreatedAt.Equal(now))
+
+	err = AddBidirectionalConversion((*time.Time)(nil), (*int64)(nil), nil, nil)
+	assertEqual(t, "forward converter is nil", err.Error())
+
+	err = AddBidirectionalConversion((*time.Time)(nil), (*string)(nil), func(in reflect.Value) (reflect.Value, error) {
+		return in, nil
+	}, nil)
+	assertEqual(t, "cannot derive reverse converter of [time.Time] to [string]", err.Error())
+}
+
+func TestAddBidirectionalConversionDerived(t *testing.T) {
+	type Money struct {
+		Amount int64
+	}
+
+	type Order struct {
+		Total Money
+	}
+
+	type OrderDto struct {
+		Total *Money
+	}
+
+	err := AddBidirectionalConversion((*Money)(nil), (**Money)(nil), func(in reflect.Value) (reflect.Value, error) {
+		pv := reflect.New(in.Type())
+		pv.Elem().Set(in)
+		return pv, nil
+	}, nil)
+	assertError(t, err)
+	defer RemoveConversion((*Money)(nil), (**Money)(nil))
+	defer RemoveConversion((**Money)(nil), (*Money)(nil))
+
+	dto := OrderDto{}
+	errs := Copy(&dto, Order{Total: Money{Amount: 100}})
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, int64(100), dto.Total.Amount)
+
+	order := Order{}
+	errs = Copy(&order, dto)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, int64(100), order.Total.Amount)
+
+	// derived pointer wrap
+	fn := deriveReverse(reflect.TypeOf(&Money{}), reflect.TypeOf(Money{}))
+	v, err := fn(reflect.ValueOf(Money{Amount: 5}))
+	assertError(t, err)
+	assertEqual(t, int64(5), v.Interface().(*Money).Amount)
+
+	// derived dereference of nil pointer
+	fn = deriveReverse(reflect.TypeOf(Money{}), reflect.TypeOf(&Money{}))
+	v, err = fn(reflect.ValueOf((*Money)(nil)))
+	assertError(t, err)
+	assertEqual(t, int64(0), v.Interface().(Money).Amount)
+}
+
+func TestAddRenameConversion(t *testing.T) {
+	type Address struct {
+		Line1 string
+		City  string
+		Zip   string
+	}
+
+	type AddressDto struct {
+		Street     string
+		City       string
+		PostalCode string
+	}
+
+	type User struct {
+		Address Address
+	}
+
+	type UserDto struct {
+		Address AddressDto
+	}
+
+	err := AddRenameConversion((*Address)(nil), (*AddressDto)(nil),
+		map[string]string{"Line1": "Street", "Zip": "PostalCode"})
+	assertError(t, err)
+	defer RemoveConversion((*Address)(nil), (*AddressDto)(nil))
+	defer RemoveConversion((*AddressDto)(nil), (*Address)(nil))
+
+	dto := UserDto{}
+	errs := Copy(&dto, User{Address: Address{Line1: "1st Street", City: "Chennai", Zip: "600001"}})
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "1st Street", dto.Address.Street)
+	assertEqual(t, "Chennai", dto.Address.City)
+	assertEqual(t, "600001", dto.Address.PostalCode)
+
+	user := User{}
+	errs = Copy(&user, dto)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "1st Street", user.Address.Line1)
+	assertEqual(t, "600001", user.Address.Zip)
+
+	err = AddRenameConversion((*Address)(nil), (*AddressDto)(nil), map[string]string{"Line1": "Line2"})
+	assertEqual(t, "Field: 'Line2', does not exists", err.Error())
+
+	err = AddRenameConversion((*string)(nil), (*AddressDto)(nil), nil)
+	assertEqual(t, "Source or Destination is not a struct", err.Error())
+}
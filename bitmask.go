@@ -0,0 +1,174 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"reflect"
+	"sort"
+)
+
+var typeOfStrings = reflect.TypeOf([]string(nil))
+
+// bitmaskMap keeps track of bit flag types and it's flag names at library level
+var bitmaskMap = map[reflect.Type]*bitmask{}
+
+type flagBit struct {
+	name string
+	bit  uint64
+}
+
+// bitmask is the flag name and bit mappings of an integer type, in the
+// order of bit value.
+type bitmask struct {
+	typ   reflect.Type
+	flags []flagBit
+}
+
+// AddBitmask method registers the flag name to bit mappings of given integer type,
+// then the bit flags field is copied to/from `[]string` of flag names by `Copy()`,
+// `Unflatten()` methods and appears as flag names in `Map()` result. It's common for
+// permission models exposed via APIs. See also `RemoveBitmask()` method.
+// 		Example:
+//
+// 		type Permission int64
+//
+// 		model.AddBitmask(Permission(0), map[string]int64{"read": 1, "write": 2, "admin": 4})
+//
+// 		// Permission(3) is copied as []string{"read", "write"} and vice versa
+//
+// Note: Type other than integer kind is ignored. Flag name which is not registered or
+// bit which has no flag name is reported as an error.
+//
+func AddBitmask(flags interface{}, names map[string]int64) {
+	t := reflect.TypeOf(flags)
+	if !isIntegerKind(t.Kind()) {
+		return
+	}
+
+	bm := &bitmask{typ: t}
+	for name, bit := range names {
+		bm.flags = append(bm.flags, flagBit{name: name, bit: uint64(bit)})
+	}
+	sort.Slice(bm.flags, func(i, j int) bool {
+		if bm.flags[i].bit == bm.flags[j].bit {
+			return bm.flags[i].name < bm.flags[j].name
+		}
+		return bm.flags[i].bit < bm.flags[j].bit
+	})
+
+	registryMu.Lock()
+	bitmaskMap[t] = bm
+	registryMu.Unlock()
+
+	AddConversionByType(t, typeOfStrings, func(in reflect.Value) (reflect.Value, error) {
+		names, err := bm.names(in)
+		return valueOf(names), err
+	})
+	AddConversionByType(typeOfStrings, t, bm.value)
+}
+
+// RemoveBitmask method removes the flag name to bit mappings of given integer type.
+func RemoveBitmask(flags interface{}) {
+	t := reflect.TypeOf(flags)
+	registryMu.Lock()
+	_, found := bitmaskMap[t]
+	delete(bitmaskMap, t)
+	registryMu.Unlock()
+
+	if !found {
+		return
+	}
+
+	RemoveConversionByType(t, typeOfStrings)
+	RemoveConversionByType(typeOfStrings, t)
+}
+
+// names method returns the flag names of the bits set in given value.
+func (bm *bitmask) names(v reflect.Value) ([]string, error) {
+	bits := bitsOf(v)
+
+	names := []string{}
+	for _, f := range bm.flags {
+		if f.bit != 0 && bits&f.bit == f.bit {
+			names = append(names, f.name)
+			bits &^= f.bit
+		}
+	}
+
+	if bits != 0 {
+		return nil, newError(ErrTypeMismatch, "bits [%b] of [%v] has no flag name", bits, bm.typ)
+	}
+
+	return names, nil
+}
+
+// value method returns the value of bit flags type for given flag names.
+func (bm *bitmask) value(in reflect.Value) (reflect.Value, error) {
+	var bits uint64
+	for i := 0; i < in.Len(); i++ {
+		name := in.Index(i).String()
+
+		found := false
+		for _, f := range bm.flags {
+			if f.name == name {
+				bits |= f.bit
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return reflect.Value{}, newError(ErrTypeMismatch, "flag '%v' is not registered for [%v]", name, bm.typ)
+		}
+	}
+
+	v := reflect.New(bm.typ).Elem()
+	switch bm.typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(int64(bits))
+	default:
+		v.SetUint(bits)
+	}
+
+	return v, nil
+}
+
+// flagNames method returns the flag names if the value type is registered
+// bit flags type.
+func flagNames(v reflect.Value) ([]string, bool) {
+	if !v.IsValid() {
+		return nil, false
+	}
+
+	registryMu.RLock()
+	bm, found := bitmaskMap[v.Type()]
+	registryMu.RUnlock()
+
+	if !found {
+		return nil, false
+	}
+
+	names, err := bm.names(v)
+	return names, err == nil
+}
+
+func bitsOf(v reflect.Value) uint64 {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return uint64(v.Int())
+	}
+
+	return v.Uint()
+}
+
+func isIntegerKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,85 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import "testing"
+
+type samplePermission int64
+
+type sampleMode uint8
+
+func TestBitmask(t *testing.T) {
+	AddBitmask(samplePermission(0), map[string]int64{"read": 1, "write": 2, "admin": 4})
+	defer RemoveBitmask(samplePermission(0))
+
+	type User struct {
+		Name        string
+		Permissions samplePermission `model:"permissions"`
+	}
+
+	type UserDto struct {
+		Name        string
+		Permissions []string
+	}
+
+	user := User{Name: "jeeva", Permissions: 5}
+
+	dto := UserDto{}
+	errs := Copy(&dto, user)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, []string{"read", "admin"}, dto.Permissions)
+
+	user = User{}
+	errs = Copy(&user, UserDto{Name: "jeeva", Permissions: []string{"write", "read"}})
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, 3, int(user.Permissions))
+
+	result, err := Map(user)
+	assertError(t, err)
+	assertEqual(t, []string{"read", "write"}, result["permissions"])
+
+	user = User{}
+	errs = Unflatten(&user, map[string]interface{}{"permissions": []string{"admin"}})
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, 4, int(user.Permissions))
+
+	errs = Copy(&user, UserDto{Name: "jeeva", Permissions: []string{"delete"}})
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, "Field: 'Permissions', flag 'delete' is not registered for [model.samplePermission]", errs[0].Error())
+
+	errs = Copy(&dto, User{Name: "jeeva", Permissions: 9})
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, "Field: 'Permissions', bits [1000] of [model.samplePermission] has no flag name", errs[0].Error())
+
+	// unknown bits are mapped as is
+	result, _ = Map(User{Permissions: 9})
+	assertEqual(t, 9, int(result["permissions"].(samplePermission)))
+}
+
+func TestBitmaskUnsigned(t *testing.T) {
+	AddBitmask(sampleMode(0), map[string]int64{"r": 4, "w": 2, "x": 1})
+	AddBitmask("not an integer", map[string]int64{"a": 1})
+
+	type File struct {
+		Mode sampleMode
+	}
+
+	type FileDto struct {
+		Mode []string
+	}
+
+	dto := FileDto{}
+	errs := Copy(&dto, File{Mode: 6})
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, []string{"w", "r"}, dto.Mode)
+
+	file := File{}
+	errs = Copy(&file, FileDto{Mode: []string{"x", "r"}})
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, 5, int(file.Mode))
+
+	RemoveBitmask(sampleMode(0))
+	assertEqual(t, false, conversionExists(typeOfStrings, valueOf(file.Mode).Type()))
+}
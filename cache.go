@@ -0,0 +1,94 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// typeInfoCache keeps the struct metadata per `reflect.Type`, so repeated calls
+// on same type don't scan the struct fields again.
+var typeInfoCache sync.Map // map[reflect.Type]*typeInfo
+
+// typeInfo is the struct metadata computed once per type.
+type typeInfo struct {
+	// fields are the exported struct fields in declaration order
+	fields []reflect.StructField
+
+	// tags are the parsed "model" tag of fields, in the same order
+	tags []*tag
+
+	// byName maps exported field name to it's struct field
+	byName map[string]reflect.StructField
+
+	// tagCache keeps the parsed tags of fields per tag names, see `tagsOf()`
+	tagCache sync.Map // map[string][]*tag
+}
+
+func typeInfoOf(t reflect.Type) *typeInfo {
+	if ti, found := typeInfoCache.Load(t); found {
+		return ti.(*typeInfo)
+	}
+
+	ti := &typeInfo{byName: map[string]reflect.StructField{}}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		// Only exported fields of a struct can be accessed.
+		// So, non-exported fields will be ignored
+		if f.PkgPath == "" {
+			ti.fields = append(ti.fields, f)
+			ti.tags = append(ti.tags, newTag(f.Tag.Get(TagName)))
+			ti.byName[f.Name] = f
+		}
+	}
+
+	actual, _ := typeInfoCache.LoadOrStore(t, ti)
+	return actual.(*typeInfo)
+}
+
+// tagsOf method returns the parsed tags of fields in the same order, the first
+// present tag of given tag names is used for each field.
+func (ti *typeInfo) tagsOf(names []string) []*tag {
+	if len(names) == 1 && names[0] == TagName {
+		return ti.tags
+	}
+
+	key := strings.Join(names, ",")
+	if tags, found := ti.tagCache.Load(key); found {
+		return tags.([]*tag)
+	}
+
+	tags := make([]*tag, len(ti.fields))
+	for i, f := range ti.fields {
+		tags[i] = newTag(lookupTag(f.Tag, names))
+	}
+
+	actual, _ := ti.tagCache.LoadOrStore(key, tags)
+	return actual.([]*tag)
+}
+
+// lookupTag method returns the value of first present tag of given tag names.
+func lookupTag(st reflect.StructTag, names []string) string {
+	for _, name := range names {
+		if v, found := st.Lookup(name); found {
+			return v
+		}
+	}
+
+	return ""
+}
+
+// structField method returns the struct field by name from the cached type
+// metadata, promoted fields of embedded struct are resolved by Go reflect.
+func structField(t reflect.Type, name string) (reflect.StructField, bool) {
+	if f, found := typeInfoOf(t).byName[name]; found {
+		return f, true
+	}
+
+	return t.FieldByName(name)
+}
@@ -0,0 +1,75 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTypeInfoCache(t *testing.T) {
+	type Base struct {
+		ID int
+	}
+
+	type SampleStruct struct {
+		Base
+		Name     string `model:"name"`
+		internal string
+	}
+
+	st := reflect.TypeOf(SampleStruct{})
+	ti := typeInfoOf(st)
+	assertEqual(t, true, ti == typeInfoOf(st))
+	assertEqual(t, 2, len(ti.fields))
+
+	// modification on result doesn't affect cache
+	fields, _ := Fields(SampleStruct{})
+	fields[0].Name = "Modified"
+	fields, _ = Fields(SampleStruct{})
+	assertEqual(t, "Base", fields[0].Name)
+
+	// promoted field is resolved
+	kind, err := Kind(SampleStruct{}, "ID")
+	assertError(t, err)
+	assertEqual(t, reflect.Int, kind)
+
+	tag, err := Tag(SampleStruct{}, "Name")
+	assertError(t, err)
+	assertEqual(t, "name", tag.Get("model"))
+
+	_, err = Kind(SampleStruct{}, "NotExists")
+	assertEqual(t, "Field: 'NotExists', does not exists", err.Error())
+}
+
+func TestTypeInfoCacheTags(t *testing.T) {
+	type SampleStruct struct {
+		Name    string `model:"name,omitempty"`
+		Address string `model:",notraverse,expand=City State"`
+		Secret  string `model:"-"`
+	}
+
+	ti := typeInfoOf(reflect.TypeOf(SampleStruct{}))
+	assertEqual(t, 3, len(ti.tags))
+	assertEqual(t, "name", ti.tags[0].Name)
+	assertEqual(t, true, ti.tags[0].isOmitEmpty())
+	assertEqual(t, false, ti.tags[0].isNoTraverse())
+	assertEqual(t, true, ti.tags[1].isNoTraverse())
+
+	names, found := ti.tags[1].expandFields()
+	assertEqual(t, true, found)
+	assertEqual(t, []string{"City", "State"}, names)
+	assertEqual(t, true, ti.tags[2].isOmitField())
+
+	// parsed tags are reused across calls
+	assertEqual(t, true, ti.tags[0] == typeInfoOf(reflect.TypeOf(SampleStruct{})).tags[0])
+}
+
+func BenchmarkMap(b *testing.B) {
+	src := SampleStruct{SampleSubInfo: SampleSubInfo{Name: "go-model", Year: 2016}}
+	for i := 0; i < b.N; i++ {
+		_, _ = Map(src)
+	}
+}
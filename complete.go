@@ -0,0 +1,111 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import "reflect"
+
+// CheckComplete method reports the destination `struct` fields which doesn't
+// receive a value from source `struct` by `Copy()` method, since the source field
+// does not exists. It's typically used in tests to catch the forgotten fields when
+// a destination type grows. Method returns the field paths (see `Get()` method),
+// otherwise nil. Input can be either value or pointer, if input is not a struct,
+// method returns nil.
+// 		Example:
+//
+// 		if paths := model.CheckComplete(Product{}, dto.Product{}); len(paths) > 0 {
+// 			t.Errorf("Fields are not populated: %v", paths)
+// 		}
+//
+// 		// Output:
+// 		Fields are not populated: [CreatedAt Vendor.Country]
+//
+// Note:
+// [1] Nested struct of different type is checked field by field.
+// [2] Embedded struct fields are checked at same level as represented by Go.
+// [3] Destination field of source "expand" option is considered as populated.
+// [4] With option `WithAccessors()`, source getter method is considered too.
+//
+// A "model" tag with the value of "-" is ignored by library for processing.
+//
+func CheckComplete(dst, src interface{}, opts ...Option) []string {
+	st, dt := structTypeOf(src), structTypeOf(dst)
+	if st == nil || dt == nil {
+		return nil
+	}
+
+	return checkComplete(dt, st, "", newOptions(opts), map[planKey]bool{})
+}
+
+func checkComplete(dt, st reflect.Type, prefix string, o *options, visiting map[planKey]bool) []string {
+	key := planKey{src: st, dst: dt}
+	if visiting[key] {
+		return nil
+	}
+	visiting[key] = true
+	defer delete(visiting, key)
+
+	expanded := expandedFields(st, o)
+
+	var paths []string
+	dti := typeInfoOf(dt)
+	dtags := o.tagsOf(dti)
+	for i, f := range dti.fields {
+		if dtags[i].isOmitField() {
+			continue
+		}
+
+		path := joinPath(prefix, f.Name)
+		sf, found := exportedField(st, f.Name)
+		if !found {
+			// embedded struct fields are populated at embedded level
+			if et := indirectType(f.Type); f.Anonymous && et.Kind() == reflect.Struct {
+				paths = append(paths, checkComplete(et, st, prefix, o, visiting)...)
+				continue
+			}
+
+			if !expanded[f.Name] && !(o.accessors && !isStringEmpty(getterOf(st, f.Name))) {
+				paths = append(paths, path)
+			}
+			continue
+		}
+
+		if o.tagOf(sf).isOmitField() {
+			paths = append(paths, path)
+			continue
+		}
+
+		// nested struct of different type is checked field by field
+		sft, dft := indirectType(sf.Type), indirectType(f.Type)
+		if sft != dft && sft.Kind() == reflect.Struct && dft.Kind() == reflect.Struct &&
+			!isNoTraverseStructType(sft) && !dtags[i].isNoTraverse() {
+			paths = append(paths, checkComplete(dft, sft, path, o, visiting)...)
+		}
+	}
+
+	return paths
+}
+
+// expandedFields method returns the destination field names of source fields
+// "expand" option.
+func expandedFields(st reflect.Type, o *options) map[string]bool {
+	names := map[string]bool{}
+	for _, t := range o.tagsOf(typeInfoOf(st)) {
+		if fields, found := t.expandFields(); found {
+			for _, name := range fields {
+				names[name] = true
+			}
+		}
+	}
+
+	return names
+}
+
+// isNoTraverseStructType method reports the struct type is in `NoTraverseTypeList`.
+func isNoTraverseStructType(t reflect.Type) bool {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	return noTraverseTypeList[t] || noTraverseTypeList[reflect.PtrTo(t)]
+}
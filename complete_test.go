@@ -0,0 +1,67 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+type SampleCompleteBase struct {
+	ID        int
+	CreatedAt time.Time
+}
+
+func TestCheckComplete(t *testing.T) {
+	type VendorDto struct {
+		Name string
+	}
+
+	type ProductDto struct {
+		ID       int
+		Name     string
+		FullName string `model:",expand=First Last"`
+		Vendor   *VendorDto
+		Internal string `model:"-"`
+	}
+
+	type Vendor struct {
+		Name    string
+		Country string
+	}
+
+	type Product struct {
+		SampleCompleteBase
+		Name     string
+		First    string
+		Last     string
+		Vendor   Vendor
+		Internal string
+		Notes    string `model:"-"`
+	}
+
+	assertEqual(t, []string{"CreatedAt", "Vendor.Country", "Internal"}, CheckComplete(Product{}, &ProductDto{}))
+
+	// same type is complete
+	assertEqual(t, true, CheckComplete(&Product{}, Product{}) == nil)
+
+	// not a struct
+	assertEqual(t, true, CheckComplete(Product{}, "product") == nil)
+}
+
+type sampleCompleteSource struct {
+	name string
+}
+
+func (s sampleCompleteSource) Name() string { return s.name }
+
+func TestCheckCompleteAccessors(t *testing.T) {
+	type Dst struct {
+		Name string
+	}
+
+	assertEqual(t, []string{"Name"}, CheckComplete(Dst{}, sampleCompleteSource{}))
+	assertEqual(t, true, CheckComplete(Dst{}, sampleCompleteSource{}, WithAccessors()) == nil)
+}
@@ -0,0 +1,101 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"reflect"
+)
+
+// ContextConverter is used to provide context-aware custom mappers for a datatype
+// pair, for eg.: converter which calls remote service or database. The context
+// is supplied by `CopyCtx()` method, other methods supply `context.Background()`.
+type ContextConverter func(ctx context.Context, in reflect.Value) (reflect.Value, error)
+
+// Context-aware type conversion functions at library level
+var contextConverterMap = map[reflect.Type]map[reflect.Type]ContextConverter{}
+
+// errConversionAborted is reported internally for the conversion which is
+// aborted due to context, it's replaced with context error by `CopyCtx()`.
+var errConversionAborted = errors.New("conversion aborted")
+
+// AddContextConversion method allows registering a custom `ContextConverter` by
+// supplying pointers of the target types. See also `CopyCtx()` method.
+// 		Example:
+//
+// 		model.AddContextConversion((*string)(nil), (*User)(nil),
+// 			func(ctx context.Context, in reflect.Value) (reflect.Value, error) {
+// 				u, err := userService.Find(ctx, in.String())
+// 				return reflect.ValueOf(u), err
+// 			})
+//
+func AddContextConversion(in interface{}, out interface{}, converter ContextConverter) {
+	AddContextConversionByType(extractType(in), extractType(out), converter)
+}
+
+// AddContextConversionByType allows registering a custom `ContextConverter` by types.
+func AddContextConversionByType(srcType reflect.Type, targetType reflect.Type, converter ContextConverter) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if _, ok := contextConverterMap[srcType]; !ok {
+		contextConverterMap[srcType] = map[reflect.Type]ContextConverter{}
+	}
+	contextConverterMap[srcType][targetType] = converter
+}
+
+// CopyCtx method is same as `Copy()` method and supplies the given context into
+// context-aware converters, see `AddContextConversion()`. Once the context is done
+// (deadline passed or canceled), the remaining conversions are aborted and the
+// context error (for eg.: `context.DeadlineExceeded`) is added to '[]error' along
+// with partially copied destination. So caller can decide partial copy is usable.
+// 		Example:
+//
+// 		ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+// 		defer cancel()
+//
+// 		errs := model.CopyCtx(ctx, &dst, src)
+// 		for _, err := range errs {
+// 			if err == context.DeadlineExceeded {
+// 				fmt.Println("Partially copied")
+// 			}
+// 		}
+//
+func CopyCtx(ctx context.Context, dst, src interface{}, opts ...Option) []error {
+	if err := validateCopyInput(dst, src); err != nil {
+		return []error{err}
+	}
+
+	o := newOptions(opts)
+	o.ctx = ctx
+	cs := newCopyState(o)
+
+	var errs []error
+	for _, err := range doCopy(valueOf(dst), valueOf(src), cs) {
+		if err != errConversionAborted {
+			errs = append(errs, err)
+		}
+	}
+
+	if cs.aborted {
+		errs = append(errs, ctx.Err())
+	}
+
+	if len(errs) > 0 {
+		return o.limitErrors(errs)
+	}
+
+	return nil
+}
+
+// context method returns the context of call, default is background context.
+func (o *options) context() context.Context {
+	if o.ctx == nil {
+		return context.Background()
+	}
+
+	return o.ctx
+}
@@ -0,0 +1,91 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type ctxUser struct {
+	ID string
+}
+
+type ctxSource struct {
+	Name    string
+	Owner   string
+	Editor  string
+	Viewers []string
+}
+
+type ctxDestination struct {
+	Name    string
+	Owner   ctxUser
+	Editor  ctxUser
+	Viewers []ctxUser
+}
+
+type ctxKey string
+
+func TestCopyCtx(t *testing.T) {
+	var calls int
+	AddContextConversion((*string)(nil), (*ctxUser)(nil), func(ctx context.Context, in reflect.Value) (reflect.Value, error) {
+		calls++
+		tenant, _ := ctx.Value(ctxKey("tenant")).(string)
+		return reflect.ValueOf(ctxUser{ID: tenant + "/" + in.String()}), nil
+	})
+	defer RemoveConversion((*string)(nil), (*ctxUser)(nil))
+
+	src := ctxSource{Name: "go-model", Owner: "jeeva", Editor: "admin", Viewers: []string{"guest"}}
+
+	// context is supplied to converter
+	ctx := context.WithValue(context.Background(), ctxKey("tenant"), "acme")
+	dst := ctxDestination{}
+	errs := CopyCtx(ctx, &dst, src)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "acme/jeeva", dst.Owner.ID)
+	assertEqual(t, "acme/guest", dst.Viewers[0].ID)
+
+	// Copy supplies background context
+	dst = ctxDestination{}
+	errs = Copy(&dst, src)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "/admin", dst.Editor.ID)
+
+	// deadline passed, conversions are aborted and partial result is returned
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	calls = 0
+	dst = ctxDestination{}
+	errs = CopyCtx(ctx, &dst, src)
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, true, errs[0] == context.DeadlineExceeded)
+	assertEqual(t, 0, calls)
+	assertEqual(t, "go-model", dst.Name)
+	assertEqual(t, "", dst.Owner.ID)
+}
+
+func TestCopyCtxCanceledDuringCopy(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	AddContextConversion((*string)(nil), (*ctxUser)(nil), func(ctx context.Context, in reflect.Value) (reflect.Value, error) {
+		// first conversion takes too long
+		cancel()
+		return reflect.ValueOf(ctxUser{ID: strings.ToUpper(in.String())}), nil
+	})
+	defer RemoveConversion((*string)(nil), (*ctxUser)(nil))
+
+	dst := ctxDestination{}
+	errs := CopyCtx(ctx, &dst, ctxSource{Name: "go-model", Owner: "jeeva", Editor: "admin"})
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, true, errs[0] == context.Canceled)
+	assertEqual(t, "JEEVA", dst.Owner.ID)
+	assertEqual(t, "", dst.Editor.ID)
+}
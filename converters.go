@@ -0,0 +1,91 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import "reflect"
+
+// Converters is the scoped set of custom converters, which is supplied per call
+// via `WithConverters()` option. Create it using `NewConverters()` method.
+type Converters struct {
+	m map[reflect.Type]map[reflect.Type]Converter
+}
+
+// NewConverters method returns the empty scoped converter set.
+func NewConverters() *Converters {
+	return &Converters{m: map[reflect.Type]map[reflect.Type]Converter{}}
+}
+
+// Add method registers a custom `Converter` into the set by supplying pointers
+// of the target types, same as `AddConversion()` method.
+func (c *Converters) Add(in interface{}, out interface{}, converter Converter) *Converters {
+	return c.AddByType(extractType(in), extractType(out), converter)
+}
+
+// AddByType method registers a custom `Converter` into the set by types.
+func (c *Converters) AddByType(srcType reflect.Type, targetType reflect.Type, converter Converter) *Converters {
+	if _, ok := c.m[srcType]; !ok {
+		c.m[srcType] = map[reflect.Type]Converter{}
+	}
+	c.m[srcType][targetType] = converter
+	return c
+}
+
+func (c *Converters) get(srcType reflect.Type, targetType reflect.Type) Converter {
+	if c == nil {
+		return nil
+	}
+
+	return c.m[srcType][targetType]
+}
+
+// WithConverters option supplies the scoped converter set for the call, it
+// takes precedence over the global converters registered via `AddConversion()`
+// and it's variants. So request specific conversion doesn't modify global state.
+// When the option is given multiple times, the last one takes precedence.
+// 		Example:
+//
+// 		convs := model.NewConverters().
+// 			Add((*float64)(nil), (*string)(nil), func(in reflect.Value) (reflect.Value, error) {
+// 				return reflect.ValueOf(printer.Sprintf("%.2f", in.Float())), nil
+// 			})
+//
+// 		errs := model.Copy(&dst, src, model.WithConverters(convs))
+//
+// Note: Converter set should not be modified while the call is in progress.
+//
+func WithConverters(c *Converters) Option {
+	return func(o *options) {
+		if c != nil {
+			o.converters = append(o.converters, c)
+		}
+	}
+}
+
+// scopedConverter method returns the converter from scoped sets of the call.
+func (o *options) scopedConverter(srcType reflect.Type, targetType reflect.Type) Converter {
+	for i := len(o.converters) - 1; i >= 0; i-- {
+		if c := o.converters[i].get(srcType, targetType); c != nil {
+			return c
+		}
+	}
+
+	return nil
+}
+
+// conversionExists method reports the converter exists in scoped sets of
+// the call or at library level.
+func (o *options) conversionExists(srcType reflect.Type, targetType reflect.Type) bool {
+	return o.scopedConverter(srcType, targetType) != nil || conversionExists(srcType, targetType)
+}
+
+// convertValue method applies the scoped converter if exists, otherwise
+// library level converter.
+func (o *options) convertValue(in reflect.Value, dt reflect.Type) (reflect.Value, error) {
+	if c := o.scopedConverter(in.Type(), dt); c != nil {
+		return c(in)
+	}
+
+	return convertValueCtx(o.context(), in, dt)
+}
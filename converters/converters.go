@@ -0,0 +1,263 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+// Package converters provides the ready-made and opt-in converters for
+// go-model library, so the common conversions need not be written in every
+// project.
+// 		Example:
+//
+// 		import "gopkg.in/jeevatkm/go-model.v1/converters"
+//
+// 		func init() {
+// 			converters.RegisterCommon()
+// 		}
+//
+package converters
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"reflect"
+	"strconv"
+	"time"
+
+	model "gopkg.in/jeevatkm/go-model.v1"
+)
+
+// TimeLayout is the layout used by time.Time <-> string converters.
+var TimeLayout = time.RFC3339
+
+var (
+	typeOfString      = reflect.TypeOf("")
+	typeOfBool        = reflect.TypeOf(false)
+	typeOfBytes       = reflect.TypeOf([]byte(nil))
+	typeOfInt64       = reflect.TypeOf(int64(0))
+	typeOfFloat64     = reflect.TypeOf(float64(0))
+	typeOfTime        = reflect.TypeOf(time.Time{})
+	typeOfDuration    = reflect.TypeOf(time.Duration(0))
+	typeOfNullString  = reflect.TypeOf(sql.NullString{})
+	typeOfNullInt64   = reflect.TypeOf(sql.NullInt64{})
+	typeOfNullFloat64 = reflect.TypeOf(sql.NullFloat64{})
+	typeOfNullBool    = reflect.TypeOf(sql.NullBool{})
+	typeOfNullTime    = reflect.TypeOf(sql.NullTime{})
+
+	intTypes = []reflect.Type{
+		reflect.TypeOf(int(0)), reflect.TypeOf(int8(0)), reflect.TypeOf(int16(0)),
+		reflect.TypeOf(int32(0)), reflect.TypeOf(int64(0)),
+	}
+
+	uintTypes = []reflect.Type{
+		reflect.TypeOf(uint(0)), reflect.TypeOf(uint8(0)), reflect.TypeOf(uint16(0)),
+		reflect.TypeOf(uint32(0)), reflect.TypeOf(uint64(0)),
+	}
+
+	floatTypes = []reflect.Type{reflect.TypeOf(float32(0)), reflect.TypeOf(float64(0))}
+)
+
+// conversion is a converter of datatype pair.
+type conversion struct {
+	in        reflect.Type
+	out       reflect.Type
+	converter model.Converter
+}
+
+// RegisterCommon method registers all the converters of this package,
+// see `RegisterStrconv()`, `RegisterTime()`, `RegisterDuration()`,
+// `RegisterBase64()` and `RegisterNull()` methods.
+func RegisterCommon() {
+	RegisterStrconv()
+	RegisterTime()
+	RegisterDuration()
+	RegisterBase64()
+	RegisterNull()
+}
+
+// UnregisterCommon method removes all the converters of this package.
+func UnregisterCommon() {
+	for _, cs := range [][]conversion{strconvConversions(), timeConversions(),
+		durationConversions(), base64Conversions(), nullConversions()} {
+		unregister(cs)
+	}
+}
+
+// RegisterStrconv method registers string <-> int, int8, int16, int32, int64,
+// uint, uint8, uint16, uint32, uint64, float32, float64 and bool converters.
+func RegisterStrconv() {
+	register(strconvConversions())
+}
+
+// RegisterTime method registers time.Time <-> string (`TimeLayout` format) and
+// time.Time <-> int64 (unix seconds) converters.
+func RegisterTime() {
+	register(timeConversions())
+}
+
+// RegisterDuration method registers time.Duration <-> string converters, for
+// eg.: "30s", "5m".
+func RegisterDuration() {
+	register(durationConversions())
+}
+
+// RegisterBase64 method registers []byte <-> string converters in standard
+// base64 encoding.
+func RegisterBase64() {
+	register(base64Conversions())
+}
+
+// RegisterNull method registers the converters between database/sql null types
+// and it's value type, sql.NullString <-> string, sql.NullInt64 <-> int64,
+// sql.NullFloat64 <-> float64, sql.NullBool <-> bool and sql.NullTime <-> time.Time.
+// Invalid null value is converted into zero value and zero value is converted
+// into invalid null value.
+func RegisterNull() {
+	register(nullConversions())
+}
+
+func register(cs []conversion) {
+	for _, c := range cs {
+		model.AddConversionByType(c.in, c.out, c.converter)
+	}
+}
+
+func unregister(cs []conversion) {
+	for _, c := range cs {
+		model.RemoveConversionByType(c.in, c.out)
+	}
+}
+
+func strconvConversions() []conversion {
+	var cs []conversion
+
+	for _, t := range intTypes {
+		t := t
+		cs = append(cs,
+			conversion{typeOfString, t, func(in reflect.Value) (reflect.Value, error) {
+				i, err := strconv.ParseInt(in.String(), 10, t.Bits())
+				if err != nil {
+					return reflect.Value{}, err
+				}
+				return reflect.ValueOf(i).Convert(t), nil
+			}},
+			conversion{t, typeOfString, func(in reflect.Value) (reflect.Value, error) {
+				return reflect.ValueOf(strconv.FormatInt(in.Int(), 10)), nil
+			}})
+	}
+
+	for _, t := range uintTypes {
+		t := t
+		cs = append(cs,
+			conversion{typeOfString, t, func(in reflect.Value) (reflect.Value, error) {
+				u, err := strconv.ParseUint(in.String(), 10, t.Bits())
+				if err != nil {
+					return reflect.Value{}, err
+				}
+				return reflect.ValueOf(u).Convert(t), nil
+			}},
+			conversion{t, typeOfString, func(in reflect.Value) (reflect.Value, error) {
+				return reflect.ValueOf(strconv.FormatUint(in.Uint(), 10)), nil
+			}})
+	}
+
+	for _, t := range floatTypes {
+		t := t
+		cs = append(cs,
+			conversion{typeOfString, t, func(in reflect.Value) (reflect.Value, error) {
+				f, err := strconv.ParseFloat(in.String(), t.Bits())
+				if err != nil {
+					return reflect.Value{}, err
+				}
+				return reflect.ValueOf(f).Convert(t), nil
+			}},
+			conversion{t, typeOfString, func(in reflect.Value) (reflect.Value, error) {
+				return reflect.ValueOf(strconv.FormatFloat(in.Float(), 'f', -1, t.Bits())), nil
+			}})
+	}
+
+	return append(cs,
+		conversion{typeOfString, typeOfBool, func(in reflect.Value) (reflect.Value, error) {
+			b, err := strconv.ParseBool(in.String())
+			return reflect.ValueOf(b), err
+		}},
+		conversion{typeOfBool, typeOfString, func(in reflect.Value) (reflect.Value, error) {
+			return reflect.ValueOf(strconv.FormatBool(in.Bool())), nil
+		}})
+}
+
+func timeConversions() []conversion {
+	return []conversion{
+		{typeOfString, typeOfTime, func(in reflect.Value) (reflect.Value, error) {
+			t, err := time.Parse(TimeLayout, in.String())
+			return reflect.ValueOf(t), err
+		}},
+		{typeOfTime, typeOfString, func(in reflect.Value) (reflect.Value, error) {
+			return reflect.ValueOf(in.Interface().(time.Time).Format(TimeLayout)), nil
+		}},
+		{typeOfInt64, typeOfTime, func(in reflect.Value) (reflect.Value, error) {
+			return reflect.ValueOf(time.Unix(in.Int(), 0).UTC()), nil
+		}},
+		{typeOfTime, typeOfInt64, func(in reflect.Value) (reflect.Value, error) {
+			return reflect.ValueOf(in.Interface().(time.Time).Unix()), nil
+		}},
+	}
+}
+
+func durationConversions() []conversion {
+	return []conversion{
+		{typeOfString, typeOfDuration, func(in reflect.Value) (reflect.Value, error) {
+			d, err := time.ParseDuration(in.String())
+			return reflect.ValueOf(d), err
+		}},
+		{typeOfDuration, typeOfString, func(in reflect.Value) (reflect.Value, error) {
+			return reflect.ValueOf(in.Interface().(time.Duration).String()), nil
+		}},
+	}
+}
+
+func base64Conversions() []conversion {
+	return []conversion{
+		{typeOfString, typeOfBytes, func(in reflect.Value) (reflect.Value, error) {
+			b, err := base64.StdEncoding.DecodeString(in.String())
+			return reflect.ValueOf(b), err
+		}},
+		{typeOfBytes, typeOfString, func(in reflect.Value) (reflect.Value, error) {
+			return reflect.ValueOf(base64.StdEncoding.EncodeToString(in.Bytes())), nil
+		}},
+	}
+}
+
+func nullConversions() []conversion {
+	return []conversion{
+		{typeOfNullString, typeOfString, func(in reflect.Value) (reflect.Value, error) {
+			return reflect.ValueOf(in.Interface().(sql.NullString).String), nil
+		}},
+		{typeOfString, typeOfNullString, func(in reflect.Value) (reflect.Value, error) {
+			return reflect.ValueOf(sql.NullString{String: in.String(), Valid: in.String() != ""}), nil
+		}},
+		{typeOfNullInt64, typeOfInt64, func(in reflect.Value) (reflect.Value, error) {
+			return reflect.ValueOf(in.Interface().(sql.NullInt64).Int64), nil
+		}},
+		{typeOfInt64, typeOfNullInt64, func(in reflect.Value) (reflect.Value, error) {
+			return reflect.ValueOf(sql.NullInt64{Int64: in.Int(), Valid: in.Int() != 0}), nil
+		}},
+		{typeOfNullFloat64, typeOfFloat64, func(in reflect.Value) (reflect.Value, error) {
+			return reflect.ValueOf(in.Interface().(sql.NullFloat64).Float64), nil
+		}},
+		{typeOfFloat64, typeOfNullFloat64, func(in reflect.Value) (reflect.Value, error) {
+			return reflect.ValueOf(sql.NullFloat64{Float64: in.Float(), Valid: in.Float() != 0}), nil
+		}},
+		{typeOfNullBool, typeOfBool, func(in reflect.Value) (reflect.Value, error) {
+			return reflect.ValueOf(in.Interface().(sql.NullBool).Bool), nil
+		}},
+		{typeOfBool, typeOfNullBool, func(in reflect.Value) (reflect.Value, error) {
+			return reflect.ValueOf(sql.NullBool{Bool: in.Bool(), Valid: in.Bool()}), nil
+		}},
+		{typeOfNullTime, typeOfTime, func(in reflect.Value) (reflect.Value, error) {
+			return reflect.ValueOf(in.Interface().(sql.NullTime).Time), nil
+		}},
+		{typeOfTime, typeOfNullTime, func(in reflect.Value) (reflect.Value, error) {
+			t := in.Interface().(time.Time)
+			return reflect.ValueOf(sql.NullTime{Time: t, Valid: !t.IsZero()}), nil
+		}},
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package converters
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+	"time"
+
+	model "gopkg.in/jeevatkm/go-model.v1"
+)
+
+type textual struct {
+	Count     string
+	Size      string
+	Ratio     string
+	Enabled   string
+	CreatedAt string
+	UpdatedAt int64
+	Timeout   string
+	Payload   string
+	Name      sql.NullString
+	Score     sql.NullInt64
+}
+
+type typed struct {
+	Count     int
+	Size      uint16
+	Ratio     float32
+	Enabled   bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Timeout   time.Duration
+	Payload   []byte
+	Name      string
+	Score     int64
+}
+
+func TestRegisterCommon(t *testing.T) {
+	RegisterCommon()
+	defer UnregisterCommon()
+
+	src := textual{
+		Count:     "-10",
+		Size:      "512",
+		Ratio:     "0.5",
+		Enabled:   "true",
+		CreatedAt: "2016-08-01T10:30:00Z",
+		UpdatedAt: 1470047400,
+		Timeout:   "30s",
+		Payload:   "Z28tbW9kZWw=",
+		Name:      sql.NullString{String: "go-model", Valid: true},
+		Score:     sql.NullInt64{Int64: 99, Valid: true},
+	}
+
+	dst := typed{}
+	errs := model.Copy(&dst, src)
+	if errs != nil {
+		t.Fatalf("Errors occurred %v", errs)
+	}
+
+	expected := typed{
+		Count:     -10,
+		Size:      512,
+		Ratio:     0.5,
+		Enabled:   true,
+		CreatedAt: time.Date(2016, 8, 1, 10, 30, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2016, 8, 1, 10, 30, 0, 0, time.UTC),
+		Timeout:   30 * time.Second,
+		Payload:   []byte("go-model"),
+		Name:      "go-model",
+		Score:     99,
+	}
+	if !reflect.DeepEqual(expected, dst) {
+		t.Errorf("Expected [%#v], got [%#v]", expected, dst)
+	}
+
+	// reverse direction
+	back := textual{}
+	errs = model.Copy(&back, dst)
+	if errs != nil {
+		t.Fatalf("Errors occurred %v", errs)
+	}
+
+	if !reflect.DeepEqual(src, back) {
+		t.Errorf("Expected [%#v], got [%#v]", src, back)
+	}
+}
+
+func TestRegisterCommonInvalidInput(t *testing.T) {
+	RegisterCommon()
+	defer UnregisterCommon()
+
+	dst := typed{}
+	errs := model.Copy(&dst, textual{Count: "ten", Size: "70000", Timeout: "30"})
+	if len(errs) != 3 {
+		t.Errorf("Expected 2 errors, got %v", errs)
+	}
+}
+
+func TestUnregisterCommon(t *testing.T) {
+	RegisterCommon()
+	UnregisterCommon()
+
+	dst := typed{}
+	errs := model.Copy(&dst, textual{Count: "10"})
+	if len(errs) == 0 {
+		t.Error("Expected type mismatch errors, converters are not unregistered")
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestCopyWithConverters(t *testing.T) {
+	AddConversion((*float64)(nil), (*string)(nil), func(in reflect.Value) (reflect.Value, error) {
+		return reflect.ValueOf("global"), nil
+	})
+	defer RemoveConversion((*float64)(nil), (*string)(nil))
+
+	type Source struct {
+		Price  float64
+		Prices []float64
+	}
+
+	type Destination struct {
+		Price  string
+		Prices []string
+	}
+
+	src := Source{Price: 10.5, Prices: []float64{1.25, 2}}
+
+	german := NewConverters().
+		Add((*float64)(nil), (*string)(nil), func(in reflect.Value) (reflect.Value, error) {
+			return reflect.ValueOf(strings.Replace(strconv.FormatFloat(in.Float(), 'f', 2, 64), ".", ",", 1)), nil
+		})
+
+	// scoped converter takes precedence over global one
+	dst := Destination{}
+	errs := Copy(&dst, src, WithConverters(german))
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "10,50", dst.Price)
+	assertEqual(t, []string{"1,25", "2,00"}, dst.Prices)
+
+	// global converter is not modified
+	dst = Destination{}
+	errs = Copy(&dst, src)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "global", dst.Price)
+
+	// last option takes precedence
+	english := NewConverters().
+		AddByType(reflect.TypeOf(float64(0)), reflect.TypeOf(""), func(in reflect.Value) (reflect.Value, error) {
+			return reflect.ValueOf(strconv.FormatFloat(in.Float(), 'f', 2, 64)), nil
+		})
+
+	dst = Destination{}
+	errs = Copy(&dst, src, WithConverters(german), WithConverters(english), WithConverters(nil))
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "10.50", dst.Price)
+}
+
+func TestCopyWithConvertersOnly(t *testing.T) {
+	type Source struct {
+		Count int
+	}
+
+	type Destination struct {
+		Count string
+	}
+
+	convs := NewConverters().Add((*int)(nil), (*string)(nil), func(in reflect.Value) (reflect.Value, error) {
+		return reflect.ValueOf(strconv.Itoa(int(in.Int()))), nil
+	})
+
+	dst := Destination{}
+	errs := Copy(&dst, Source{Count: 7}, WithConverters(convs))
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "7", dst.Count)
+
+	// without scoped converter kind doesn't match
+	dst = Destination{}
+	errs = Copy(&dst, Source{Count: 7})
+	assertEqual(t, "Field: 'Count', src [int] & dst [string] kind didn't match", errs[0].Error())
+}
+
+func TestFromMapWithConverters(t *testing.T) {
+	type Money struct {
+		Cents int64
+	}
+
+	type Order struct {
+		Total  Money
+		Totals map[string]Money
+		Count  int
+	}
+
+	convs := NewConverters().
+		Add((*string)(nil), (*Money)(nil), func(in reflect.Value) (reflect.Value, error) {
+			f, err := strconv.ParseFloat(in.String(), 64)
+			return reflect.ValueOf(Money{Cents: int64(f * 100)}), err
+		}).
+		Add((*string)(nil), (*int)(nil), func(in reflect.Value) (reflect.Value, error) {
+			return reflect.ValueOf(len(in.String())), nil
+		})
+
+	m := map[string]interface{}{
+		"Total":  "10.50",
+		"Totals": map[string]interface{}{"tax": "1.25"},
+		"Count":  "three",
+	}
+
+	dst := Order{}
+	errs := FromMap(&dst, m, WithConverters(convs))
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, int64(1050), dst.Total.Cents)
+	assertEqual(t, int64(125), dst.Totals["tax"].Cents)
+	assertEqual(t, 5, dst.Count)
+
+	v, errs := Construct(reflect.TypeOf(Order{}), m, WithConverters(convs))
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, int64(1050), v.(*Order).Total.Cents)
+
+	sdst := Order{}
+	errs = FromStringMap(&sdst, map[string]string{"Total": "2.00", "Count": "three"}, WithConverters(convs))
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, int64(200), sdst.Total.Cents)
+	assertEqual(t, 5, sdst.Count)
+
+	// without scoped converter
+	errs = FromMap(&Order{}, m)
+	assertEqual(t, 3, len(errs))
+}
@@ -0,0 +1,75 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import "reflect"
+
+// CopierTo is implemented by the source type which takes full control over its
+// own mapping, `Copy()` method delegates entirely to it, similar to `json.Marshaler`.
+// It's applicable on root and nested struct values, dst is always a pointer of
+// destination struct. Pointer receiver method is used too.
+// 		Example:
+//
+// 		func (o Order) CopyTo(dst interface{}) error {
+// 			switch d := dst.(type) {
+// 			case *dto.Order:
+// 				d.ID = o.ID.String()
+// 				d.Total = o.Total.StringFixed(2)
+// 				return nil
+// 			}
+//
+// 			// fallback to default mapping, type conversion drops the method
+// 			type order Order
+// 			return model.CopyE(dst, order(o))
+// 		}
+//
+// Note: Calling `Copy()` method with the receiver itself from `CopyTo` leads to
+// infinite recursion, use the type conversion as shown above.
+type CopierTo interface {
+	CopyTo(dst interface{}) error
+}
+
+var typeOfCopierTo = reflect.TypeOf((*CopierTo)(nil)).Elem()
+
+// copierOf method returns the `CopierTo` implementation of the value, pointer
+// receiver method is used too. ok is false if it's not implemented or value
+// is not accessible.
+func copierOf(v reflect.Value) (copier CopierTo, ok bool) {
+	if !v.IsValid() || !v.CanInterface() {
+		return nil, false
+	}
+
+	t := v.Type()
+	if t.Implements(typeOfCopierTo) {
+		if isPtr(v) && v.IsNil() {
+			return nil, false
+		}
+
+		return v.Interface().(CopierTo), true
+	}
+
+	if t.Kind() != reflect.Ptr && reflect.PtrTo(t).Implements(typeOfCopierTo) {
+		return addressable(v).Addr().Interface().(CopierTo), true
+	}
+
+	return nil, false
+}
+
+// copyTo method delegates the copy into `CopierTo` implementation with the
+// pointer of destination struct.
+func copyTo(dv reflect.Value, copier CopierTo) []error {
+	if !isPtr(dv) {
+		if !dv.CanAddr() {
+			return nil
+		}
+		dv = dv.Addr()
+	}
+
+	if err := copier.CopyTo(dv.Interface()); err != nil {
+		return []error{err}
+	}
+
+	return nil
+}
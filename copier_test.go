@@ -0,0 +1,106 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+type sampleCopierMoney struct {
+	Units int64
+	Nanos int32
+}
+
+type sampleCopierDto struct {
+	Amount string
+}
+
+func (m sampleCopierMoney) CopyTo(dst interface{}) error {
+	switch d := dst.(type) {
+	case *sampleCopierDto:
+		d.Amount = strconv.FormatInt(m.Units, 10) + "." + strconv.Itoa(int(m.Nanos/10000000))
+		return nil
+	case *sampleCopierMoney:
+		// fallback to default mapping
+		type money sampleCopierMoney
+		return CopyE(d, money(m))
+	}
+
+	return errors.New("unsupported destination")
+}
+
+type sampleLedger struct {
+	Name string
+}
+
+func (l *sampleLedger) CopyTo(dst interface{}) error {
+	if l.Name == "closed" {
+		return errors.New("ledger is closed")
+	}
+
+	dst.(*sampleLedger).Name = "copy of " + l.Name
+	return nil
+}
+
+func TestCopierTo(t *testing.T) {
+	// root
+	var dto sampleCopierDto
+	errs := Copy(&dto, sampleCopierMoney{Units: 12, Nanos: 500000000})
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, "12.50", dto.Amount)
+
+	var m sampleCopierMoney
+	errs = Copy(&m, &sampleCopierMoney{Units: 3, Nanos: 10})
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, int64(3), m.Units)
+	assertEqual(t, int32(10), m.Nanos)
+
+	// nested struct of different type
+	type Invoice struct {
+		No    string
+		Total sampleCopierMoney
+		Tax   *sampleCopierMoney
+	}
+
+	type InvoiceDto struct {
+		No    string
+		Total sampleCopierDto
+		Tax   *sampleCopierDto
+	}
+
+	var idto InvoiceDto
+	errs = Copy(&idto, Invoice{No: "INV-1", Total: sampleCopierMoney{Units: 99, Nanos: 900000000}, Tax: &sampleCopierMoney{Units: 9}})
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, "INV-1", idto.No)
+	assertEqual(t, "99.90", idto.Total.Amount)
+	assertEqual(t, "9.0", idto.Tax.Amount)
+
+	// pointer receiver and error
+	type Book struct {
+		Ledger sampleLedger
+	}
+
+	var book Book
+	errs = Copy(&book, Book{Ledger: sampleLedger{Name: "2026"}})
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, "copy of 2026", book.Ledger.Name)
+
+	var ledger sampleLedger
+	errs = Copy(&ledger, &sampleLedger{Name: "closed"})
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, "ledger is closed", errs[0].Error())
+
+	// nested error is reported with field path
+	errs = Copy(&book, Book{Ledger: sampleLedger{Name: "closed"}})
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, "Field: 'Ledger', ledger is closed", errs[0].Error())
+
+	// clone
+	v, err := Clone(sampleLedger{Name: "2025"})
+	assertError(t, err)
+	assertEqual(t, "copy of 2025", v.(*sampleLedger).Name)
+}
@@ -0,0 +1,100 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+)
+
+// ToCSVRecord method converts the given `struct` into CSV record as per given
+// header, column name is the dot-path key of field same as `Flatten()` method, for
+// eg.: "address.city" and "items[0].id". Column without a matching field or with
+// nil value is empty, `time.Time` is formatted in RFC3339 format. It accepts the
+// options of `Flatten()` method.
+// 		Example:
+//
+// 		header := []string{"id", "name", "address.city"}
+// 		w := csv.NewWriter(os.Stdout)
+// 		w.Write(header)
+//
+// 		for _, p := range products {
+// 			record, _ := model.ToCSVRecord(p, header)
+// 			w.Write(record)
+// 		}
+// 		w.Flush()
+//
+func ToCSVRecord(s interface{}, header []string, opts ...Option) ([]string, error) {
+	m, err := Flatten(s, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	record := make([]string, len(header))
+	for i, column := range header {
+		if v, found := m[column]; found {
+			record[i] = formatCSVValue(valueOf(v))
+		}
+	}
+
+	return record, nil
+}
+
+// FromCSVRecord method is the inverse of `ToCSVRecord()` method. It populates the
+// destination `struct` from the given CSV record as per header, the column value is
+// parsed into field type same as `FromURLValues()` method; empty column value is
+// zero value of the field. Column without a matching field is ignored. It accepts
+// the options of `FromMap()` method.
+// 		Example:
+//
+// 		r := csv.NewReader(file)
+// 		header, _ := r.Read()
+//
+// 		for {
+// 			record, err := r.Read()
+// 			if err == io.EOF {
+// 				break
+// 			}
+//
+// 			var p Product
+// 			if errs := model.FromCSVRecord(&p, header, record); errs != nil {
+// 				fmt.Println("Errors:", errs)
+// 			}
+// 		}
+//
+func FromCSVRecord(dst interface{}, header, record []string, opts ...Option) []error {
+	if len(header) != len(record) {
+		return []error{fmt.Errorf("header has %d columns, but record has %d", len(header), len(record))}
+	}
+
+	values := url.Values{}
+	for i, column := range header {
+		values.Add(column, record[i])
+	}
+
+	return FromURLValues(dst, values, opts...)
+}
+
+// formatCSVValue method formats the flattened value as column value, nil pointer
+// and empty slice or map is empty.
+func formatCSVValue(v reflect.Value) string {
+	if isPtr(v) {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+
+	if !v.IsValid() {
+		return ""
+	}
+
+	if (v.Kind() == reflect.Slice || v.Kind() == reflect.Map) && v.Type() != typeOfBytes {
+		return ""
+	}
+
+	return formatURLValue(v)
+}
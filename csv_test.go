@@ -0,0 +1,71 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+type sampleCSVAddress struct {
+	City string `model:"city"`
+}
+
+type sampleCSVProduct struct {
+	ID        int              `model:"id"`
+	Name      string           `model:"name"`
+	Price     float64          `model:"price"`
+	InStock   bool             `model:"in_stock"`
+	Discount  *float64         `model:"discount"`
+	Tags      []string         `model:"tags"`
+	Address   sampleCSVAddress `model:"address"`
+	CreatedAt time.Time        `model:"created_at"`
+}
+
+func TestToCSVRecord(t *testing.T) {
+	src := sampleCSVProduct{
+		ID:        101,
+		Name:      "go-model",
+		Price:     9.5,
+		InStock:   true,
+		Tags:      []string{"go"},
+		Address:   sampleCSVAddress{City: "Chennai"},
+		CreatedAt: time.Date(2017, 1, 2, 15, 4, 5, 0, time.UTC),
+	}
+
+	header := []string{"id", "name", "price", "in_stock", "discount", "tags[0]", "address.city", "created_at", "unknown"}
+	record, err := ToCSVRecord(src, header)
+	assertError(t, err)
+	assertEqual(t, []string{"101", "go-model", "9.5", "true", "", "go", "Chennai", "2017-01-02T15:04:05Z", ""}, record)
+
+	// round trip
+	var dst sampleCSVProduct
+	errs := FromCSVRecord(&dst, header, record)
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, 101, dst.ID)
+	assertEqual(t, "go-model", dst.Name)
+	assertEqual(t, 9.5, dst.Price)
+	assertEqual(t, true, dst.InStock)
+	assertEqual(t, true, dst.Discount == nil)
+	assertEqual(t, []string{"go"}, dst.Tags)
+	assertEqual(t, "Chennai", dst.Address.City)
+	assertEqual(t, true, dst.CreatedAt.Equal(src.CreatedAt))
+}
+
+func TestFromCSVRecord(t *testing.T) {
+	var dst sampleCSVProduct
+	errs := FromCSVRecord(&dst, []string{"id", "name", "price", "discount"}, []string{"101", "go-model", "", "0.5"})
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, 101, dst.ID)
+	assertEqual(t, 0.0, dst.Price)
+	assertEqual(t, 0.5, *dst.Discount)
+
+	errs = FromCSVRecord(&dst, []string{"id", "name"}, []string{"101"})
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, "header has 2 columns, but record has 1", errs[0].Error())
+
+	errs = FromCSVRecord(&dst, []string{"id"}, []string{"one"})
+	assertEqual(t, 1, len(errs))
+}
@@ -0,0 +1,126 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// mapState holds the state of single map process, it detects the reference
+// cycle which cannot be represented in the map.
+type mapState struct {
+	// visiting keeps track of pointers and maps which are in map process
+	visiting map[visitKey]bool
+
+	// segs is the field path of the value in map process
+	segs []mapSegment
+
+	// err is the first reference cycle error
+	err error
+}
+
+// mapSegment is one part of field path in map process, it's either field
+// name, slice index or map key.
+type mapSegment struct {
+	name  string
+	index int
+	key   reflect.Value
+}
+
+// newMapState method returns the map state for given value, root pointer is
+// in traversal too, so nested back reference is detected.
+func newMapState(v reflect.Value) *mapState {
+	ms := &mapState{segs: make([]mapSegment, 0, 8)}
+	if isPtr(v) && !v.IsNil() {
+		ms.enter(v)
+	}
+
+	return ms
+}
+
+// enter method marks the pointer or map as in traversal, it returns false and
+// records the error if the value is already in traversal.
+func (ms *mapState) enter(v reflect.Value) bool {
+	if ms == nil {
+		return true
+	}
+
+	// visiting is allocated only if pointer is traversed
+	if ms.visiting == nil {
+		ms.visiting = map[visitKey]bool{}
+	}
+
+	key := visitKeyOf(v)
+	if ms.visiting[key] {
+		if ms.err == nil {
+			ms.err = newFieldError(ms.path(), nil, nil, ErrCycle, "cycle detected")
+		}
+		return false
+	}
+
+	ms.visiting[key] = true
+	return true
+}
+
+// leave method marks the pointer or map as traversed.
+func (ms *mapState) leave(v reflect.Value) {
+	if ms != nil {
+		delete(ms.visiting, visitKeyOf(v))
+	}
+}
+
+// failed method reports the reference cycle is found, so the map process
+// can be stopped.
+func (ms *mapState) failed() bool {
+	return ms != nil && ms.err != nil
+}
+
+func (ms *mapState) push() {
+	if ms != nil {
+		ms.segs = append(ms.segs, mapSegment{})
+	}
+}
+
+func (ms *mapState) pop() {
+	if ms != nil {
+		ms.segs = ms.segs[:len(ms.segs)-1]
+	}
+}
+
+// field, index and key methods set the current path segment.
+func (ms *mapState) field(name string) {
+	if ms != nil {
+		ms.segs[len(ms.segs)-1] = mapSegment{name: name}
+	}
+}
+
+func (ms *mapState) index(i int) {
+	if ms != nil {
+		ms.segs[len(ms.segs)-1] = mapSegment{index: i}
+	}
+}
+
+func (ms *mapState) key(k reflect.Value) {
+	if ms != nil {
+		ms.segs[len(ms.segs)-1] = mapSegment{key: k}
+	}
+}
+
+func (ms *mapState) path() string {
+	var path string
+	for _, seg := range ms.segs {
+		switch {
+		case seg.key.IsValid():
+			path = fmt.Sprintf("%v[%v]", path, seg.key)
+		case isStringEmpty(seg.name):
+			path = fmt.Sprintf("%v[%d]", path, seg.index)
+		default:
+			path = joinPath(path, seg.name)
+		}
+	}
+
+	return path
+}
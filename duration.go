@@ -0,0 +1,45 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+)
+
+var typeOfJSONNumber = reflect.TypeOf(json.Number(""))
+
+// isDurationConvertible method reports the source and destination type are
+// `time.Duration` and string, duration string is in `time.ParseDuration()`
+// format, for eg.: "30s", "5m".
+func isDurationConvertible(st, dt reflect.Type) bool {
+	return (st == typeOfDuration && dt.Kind() == reflect.String) ||
+		(st.Kind() == reflect.String && dt == typeOfDuration)
+}
+
+// convertDuration method converts the `time.Duration` into string and vice versa.
+// JSON number is the nanoseconds as `ToJSON()` method writes the duration.
+func convertDuration(v reflect.Value, dt reflect.Type) (reflect.Value, error) {
+	if v.Type() == typeOfDuration {
+		return valueOf(time.Duration(v.Int()).String()).Convert(dt), nil
+	}
+
+	if v.Type() == typeOfJSONNumber {
+		n, err := json.Number(v.String()).Int64()
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		return valueOf(time.Duration(n)), nil
+	}
+
+	d, err := time.ParseDuration(v.String())
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	return valueOf(d), nil
+}
@@ -0,0 +1,61 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+type sampleDurationText string
+
+func TestCopyDuration(t *testing.T) {
+	type Config struct {
+		Timeout  time.Duration
+		Interval time.Duration
+		Grace    time.Duration
+	}
+
+	type ConfigDto struct {
+		Timeout  string
+		Interval sampleDurationText
+		Grace    string
+	}
+
+	var dto ConfigDto
+	errs := Copy(&dto, Config{Timeout: 30 * time.Second, Interval: 5 * time.Minute})
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, "30s", dto.Timeout)
+	assertEqual(t, true, dto.Interval == "5m0s")
+	assertEqual(t, "", dto.Grace)
+
+	var cfg Config
+	errs = Copy(&cfg, ConfigDto{Timeout: "1m30s", Interval: "250ms"})
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, true, cfg.Timeout == 90*time.Second)
+	assertEqual(t, true, cfg.Interval == 250*time.Millisecond)
+	assertEqual(t, true, cfg.Grace == 0)
+
+	errs = Copy(&cfg, ConfigDto{Timeout: "thirty"})
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, `Field: 'Timeout', time: invalid duration "thirty"`, errs[0].Error())
+}
+
+func TestFromMapDuration(t *testing.T) {
+	type Config struct {
+		Timeout time.Duration
+		Label   string
+	}
+
+	var cfg Config
+	errs := FromMap(&cfg, map[string]interface{}{"Timeout": "5m", "Label": 30 * time.Second})
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, true, cfg.Timeout == 5*time.Minute)
+	assertEqual(t, "30s", cfg.Label)
+
+	errs = FromMap(&cfg, map[string]interface{}{"Timeout": "5 minutes"})
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, `Field: 'Timeout', time: unknown unit " minutes" in duration "5 minutes"`, errs[0].Error())
+}
@@ -0,0 +1,182 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"os"
+	"reflect"
+	"strings"
+)
+
+// EnvTagName is used for environment variable name of the field in `FromEnv()`
+// method.
+const EnvTagName = "env"
+
+// FromEnv method populates the exported fields of destination `struct` from the
+// environment variables. Variable name is the "env" tag value, otherwise key name
+// of the field (see `Map()` method) in upper snake case, for eg.: "MaxConns" field
+// is "MAX_CONNS". Given prefix and nested struct key name are joined with "_".
+// Embedded struct fields are looked up at same level as represented by Go.
+// 		Example:
+//
+// 		type Config struct {
+// 			Port     int           `env:"PORT"`
+// 			Timeout  time.Duration // APP_TIMEOUT
+// 			Hosts    []string      // APP_HOSTS, comma separated
+// 			Database struct {
+// 				URL string // APP_DATABASE_URL
+// 			}
+// 		}
+//
+// 		cfg := Config{Port: 8080}
+// 		errs := model.FromEnv(&cfg, "APP")
+// 		if errs != nil {
+// 			fmt.Println("Errors:", errs)
+// 		}
+//
+// The value is parsed into the field type same as `FromStringMap()` method, slice
+// value is comma separated and the field type which implements
+// `encoding.TextUnmarshaler` parses the value itself. Field retains it's value if
+// the variable is not set.
+//
+// FromEnv method accepts the `Option`(s), for eg.: `WithTagName()` to use the
+// custom tag in place of "model" tag, `WithConverters()` to parse the value with
+// converters scoped to the call.
+//
+// A "env" or "model" tag with the value of "-" is ignored by library for processing.
+//
+// A "model" tag value with the option of "notraverse"; library will not traverse
+// inside the struct object, the value is parsed into struct type.
+//
+func FromEnv(dst interface{}, prefix string, opts ...Option) []error {
+	dv, err := destStructValue(dst)
+	if err != nil {
+		return []error{err}
+	}
+
+	errs, _ := fromEnv(dv, prefix, "", newOptions(opts))
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// fromEnv method populates the struct from environment variables, it reports
+// whether any of the variable is set.
+func fromEnv(dv reflect.Value, prefix, path string, o *options) ([]error, bool) {
+	var (
+		errs  []error
+		found bool
+	)
+
+	for _, f := range modelFields(dv) {
+		fv := dv.FieldByIndex(f.Index)
+		tag := o.tagOf(f)
+		envName := f.Tag.Get(EnvTagName)
+
+		if tag.isOmitField() || envName == OmitField || !fv.CanSet() {
+			continue
+		}
+
+		if isStringEmpty(envName) {
+			envName = strings.ToUpper(snakeCase(tag.keyName(f)))
+		}
+
+		ft := indirectType(f.Type)
+		nested := ft.Kind() == reflect.Struct && ft != typeOfTime && !tag.isNoTraverse() &&
+			!isNoTraverseType(reflect.Zero(ft)) && !reflect.PtrTo(ft).Implements(typeOfTextUnmarshaler)
+
+		// nested struct fields are looked up with it's prefix, embedded struct
+		// fields at same level
+		if nested {
+			np := prefix
+			if !f.Anonymous {
+				np = joinEnvName(prefix, envName)
+			}
+
+			ev := reflect.New(ft).Elem()
+			if isPtr(fv) && !fv.IsNil() {
+				ev = fv.Elem()
+			} else if !isPtr(fv) {
+				ev = fv
+			}
+
+			innerErrs, innerFound := fromEnv(ev, np, joinPath(path, f.Name), o)
+			errs = append(errs, innerErrs...)
+			if innerFound && isPtr(fv) && fv.IsNil() {
+				fv.Set(ev.Addr())
+			}
+			found = found || innerFound
+			continue
+		}
+
+		str, exists := os.LookupEnv(joinEnvName(prefix, envName))
+		if !exists {
+			continue
+		}
+		found = true
+
+		errs = append(errs, parseEnv(fv, str, joinPath(path, f.Name), o)...)
+	}
+
+	return errs, found
+}
+
+// parseEnv method parses the variable value into field, slice value is comma
+// separated.
+func parseEnv(fv reflect.Value, str, path string, o *options) []error {
+	ft := fv.Type()
+
+	if o.conversionExists(typeOfString, ft) {
+		return assignField(fv, valueOf(str), path, o)
+	}
+
+	if ft.Kind() == reflect.Ptr {
+		ev := reflect.New(ft.Elem())
+		if errs := parseEnv(ev.Elem(), str, path, o); len(errs) > 0 {
+			return errs
+		}
+
+		fv.Set(ev)
+		return nil
+	}
+
+	if handled, errs := unmarshalText(fv, str, path); handled {
+		return errs
+	}
+
+	if ft.Kind() == reflect.Slice && ft != typeOfBytes {
+		var (
+			errs  []error
+			parts []string
+		)
+		if !isStringEmpty(str) {
+			parts = strings.Split(str, ",")
+		}
+
+		sv := reflect.MakeSlice(ft, len(parts), len(parts))
+		for i, part := range parts {
+			errs = append(errs, parseEnv(sv.Index(i), strings.TrimSpace(part), path, o)...)
+		}
+
+		if len(errs) > 0 {
+			return errs
+		}
+
+		fv.Set(sv)
+		return nil
+	}
+
+	return assignField(fv, valueOf(str), path, o)
+}
+
+func joinEnvName(prefix, name string) string {
+	if isStringEmpty(prefix) {
+		return name
+	}
+
+	return prefix + "_" + name
+}
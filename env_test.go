@@ -0,0 +1,113 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+)
+
+type sampleEnvDatabase struct {
+	URL      string
+	MaxConns int
+}
+
+type SampleEnvLog struct {
+	Level string
+}
+
+type sampleEnvConfig struct {
+	SampleEnvLog
+	Port      int    `env:"PORT"`
+	Host      string `model:"hostName"`
+	Debug     bool   `model:"debug"`
+	Timeout   time.Duration
+	StartedAt time.Time
+	Hosts     []string
+	Ports     []int
+	Addr      net.IP
+	Ratio     *float64
+	Database  sampleEnvDatabase
+	Cache     *sampleEnvDatabase
+	Replica   *sampleEnvDatabase
+	Secret    string `env:"-"`
+	Internal  string `model:"-"`
+}
+
+func TestFromEnv(t *testing.T) {
+	t.Setenv("PORT", "9090")
+	t.Setenv("APP_HOST_NAME", "localhost")
+	t.Setenv("APP_DEBUG", "true")
+	t.Setenv("APP_TIMEOUT", "30s")
+	t.Setenv("APP_STARTED_AT", "2017-01-02T15:04:05Z")
+	t.Setenv("APP_HOSTS", "a.example.com, b.example.com")
+	t.Setenv("APP_PORTS", "80,443")
+	t.Setenv("APP_ADDR", "10.0.0.1")
+	t.Setenv("APP_RATIO", "0.5")
+	t.Setenv("APP_LEVEL", "debug")
+	t.Setenv("APP_DATABASE_URL", "postgres://localhost/app")
+	t.Setenv("APP_DATABASE_MAX_CONNS", "10")
+	t.Setenv("APP_CACHE_URL", "redis://localhost")
+	t.Setenv("APP_SECRET", "s3cr3t")
+	t.Setenv("APP_INTERNAL", "internal")
+
+	cfg := sampleEnvConfig{Port: 8080, Host: "0.0.0.0"}
+	errs := FromEnv(&cfg, "APP")
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, 8080, cfg.Port)
+	assertEqual(t, "localhost", cfg.Host)
+	assertEqual(t, true, cfg.Debug)
+	assertEqual(t, true, cfg.Timeout == 30*time.Second)
+	assertEqual(t, true, cfg.StartedAt.Equal(time.Date(2017, 1, 2, 15, 4, 5, 0, time.UTC)))
+	assertEqual(t, []string{"a.example.com", "b.example.com"}, cfg.Hosts)
+	assertEqual(t, []int{80, 443}, cfg.Ports)
+	assertEqual(t, "10.0.0.1", cfg.Addr.String())
+	assertEqual(t, 0.5, *cfg.Ratio)
+	assertEqual(t, "debug", cfg.Level)
+	assertEqual(t, "postgres://localhost/app", cfg.Database.URL)
+	assertEqual(t, 10, cfg.Database.MaxConns)
+	assertEqual(t, "redis://localhost", cfg.Cache.URL)
+	assertEqual(t, true, cfg.Replica == nil)
+	assertEqual(t, "", cfg.Secret)
+	assertEqual(t, "", cfg.Internal)
+
+	// without prefix
+	cfg = sampleEnvConfig{}
+	errs = FromEnv(&cfg, "")
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, 9090, cfg.Port)
+}
+
+func TestFromEnvErrors(t *testing.T) {
+	t.Setenv("APP_PORTS", "80,https")
+	t.Setenv("APP_DATABASE_MAX_CONNS", "ten")
+
+	var cfg sampleEnvConfig
+	errs := FromEnv(&cfg, "APP")
+	assertEqual(t, 2, len(errs))
+	assertEqual(t, `Field: 'Ports', strconv.ParseInt: parsing "https": invalid syntax`, errs[0].Error())
+	assertEqual(t, `Field: 'Database.MaxConns', strconv.ParseInt: parsing "ten": invalid syntax`, errs[1].Error())
+
+	errs = FromEnv(cfg, "APP")
+	assertEqual(t, true, errors.Is(errs[0], ErrNotPointer))
+}
+
+func TestFromEnvWithTagName(t *testing.T) {
+	type Config struct {
+		Host     string `cfg:"hostName" model:"host"`
+		Internal string `cfg:"-"`
+	}
+
+	t.Setenv("APP_HOST_NAME", "localhost")
+	t.Setenv("APP_INTERNAL", "internal")
+
+	var cfg Config
+	errs := FromEnv(&cfg, "APP", WithTagName("cfg"))
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, "localhost", cfg.Host)
+	assertEqual(t, "", cfg.Internal)
+}
@@ -0,0 +1,203 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Sentinel errors of go-model, returned errors wrap one of these so the caller
+// can branch with `errors.Is` instead of matching the error message.
+// 		Example:
+//
+// 		errs := model.Copy(&dst, src)
+// 		for _, err := range errs {
+// 			if errors.Is(err, model.ErrTypeMismatch) {
+// 				// handle the mismatched field
+// 			}
+// 		}
+//
+var (
+	// ErrNilInput is returned when the input is nil
+	ErrNilInput = errors.New("nil input")
+
+	// ErrNotPointer is returned when the destination is not a pointer
+	ErrNotPointer = errors.New("not a pointer")
+
+	// ErrNotStruct is returned when the input is not a struct
+	ErrNotStruct = errors.New("not a struct")
+
+	// ErrEmptyStruct is returned when the source struct is zero value
+	ErrEmptyStruct = errors.New("empty struct")
+
+	// ErrFieldNotFound is returned when the field or path does not exists
+	ErrFieldNotFound = errors.New("field not found")
+
+	// ErrTypeMismatch is returned when the source and destination kind or type
+	// didn't match
+	ErrTypeMismatch = errors.New("type mismatch")
+
+	// ErrNotSettable is returned when the field cannot be set
+	ErrNotSettable = errors.New("not settable")
+
+	// ErrRequired is returned when the field has "required" option and it's
+	// zero value
+	ErrRequired = errors.New("required field is zero")
+
+	// ErrCycle is returned when the value has reference cycle which cannot be
+	// represented in the result
+	ErrCycle = errors.New("cycle detected")
+
+	sentinelErrors = []error{ErrNilInput, ErrNotPointer, ErrNotStruct, ErrEmptyStruct,
+		ErrFieldNotFound, ErrTypeMismatch, ErrNotSettable, ErrRequired, ErrCycle}
+)
+
+// modelError is the error with message which wraps the sentinel error.
+type modelError struct {
+	msg string
+	err error
+}
+
+func (e *modelError) Error() string {
+	return e.msg
+}
+
+func (e *modelError) Unwrap() error {
+	return e.err
+}
+
+// newError method returns the formatted error which wraps given sentinel error.
+func newError(sentinel error, format string, a ...interface{}) error {
+	return &modelError{msg: fmt.Sprintf(format, a...), err: sentinel}
+}
+
+// causeError is the error which wraps the sentinel error along with it's cause,
+// so `errors.Is` and `errors.As` works with both.
+type causeError struct {
+	sentinel error
+	cause    error
+}
+
+func (e *causeError) Error() string {
+	return e.cause.Error()
+}
+
+func (e *causeError) Unwrap() []error {
+	return []error{e.sentinel, e.cause}
+}
+
+// withSentinel method returns the error which wraps given sentinel error along
+// with the cause, the cause is returned as-is if it wraps sentinel error already.
+func withSentinel(sentinel, err error) error {
+	for _, se := range sentinelErrors {
+		if errors.Is(err, se) {
+			return err
+		}
+	}
+
+	return &causeError{sentinel: sentinel, cause: err}
+}
+
+// FieldError is the error of field in copy process, it carries the field path,
+// source and destination types. It wraps the cause, so `errors.Is` and
+// `errors.As` works with it.
+// 		Example:
+//
+// 		for _, err := range model.Copy(&dst, src) {
+// 			var fe *model.FieldError
+// 			if errors.As(err, &fe) {
+// 				fmt.Printf("%v: %v\n", fe.Path, fe.Reason)
+// 			}
+// 		}
+//
+type FieldError struct {
+	// Path is the field path from the root struct, nested struct field is
+	// dotted and embedded struct field is promoted, for eg.: "Level1.Level2.Name"
+	Path string
+
+	// SrcType is the type of source field value, nil if not known
+	SrcType reflect.Type
+
+	// DstType is the type of destination field, nil if not known
+	DstType reflect.Type
+
+	// Reason describes why the field is not copied
+	Reason string
+
+	// Err is the cause, it's either sentinel error or the converter error
+	Err error
+}
+
+// Error method returns the error message as "Field: '<path>', <reason>".
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("Field: '%v', %v", e.Path, e.Reason)
+}
+
+// Unwrap method returns the cause of field error.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// newFieldError method returns the field error of given types and cause with
+// formatted reason.
+func newFieldError(path string, st, dt reflect.Type, err error, format string, a ...interface{}) *FieldError {
+	return &FieldError{
+		Path:    path,
+		SrcType: st,
+		DstType: dt,
+		Reason:  fmt.Sprintf(format, a...),
+		Err:     err,
+	}
+}
+
+// valueError method returns the field error of value which could not be set into
+// the field, `ErrTypeMismatch` is wrapped along with the cause unless it wraps
+// sentinel error already.
+func valueError(path string, val reflect.Value, dt reflect.Type, err error) *FieldError {
+	var st reflect.Type
+	if val.IsValid() {
+		st = val.Type()
+	}
+
+	return newFieldError(path, st, dt, withSentinel(ErrTypeMismatch, err), "%v", err)
+}
+
+// fieldErrors method returns the errors of field copy with path, nested field
+// errors are prefixed with the field name and others are wrapped as `FieldError`.
+// Embedded struct field name is empty, since its fields are promoted.
+func fieldErrors(name string, st, dt reflect.Type, errs []error) []error {
+	for i, err := range errs {
+		switch e := err.(type) {
+		case *FieldError:
+			e.Path = joinElemPath(name, e.Path)
+		case *HandleWarning:
+			// warning is not specific to field
+		default:
+			if err != errConversionAborted && !isStringEmpty(name) {
+				errs[i] = newFieldError(name, st, dt, err, "%v", err)
+			}
+		}
+	}
+
+	return errs
+}
+
+// elemErrors method returns the errors of slice, array or map element copy with
+// the index or key in path, for eg.: "[0].Name".
+func elemErrors(index interface{}, st, dt reflect.Type, errs []error) []error {
+	return fieldErrors(fmt.Sprintf("[%v]", index), st, dt, errs)
+}
+
+// joinElemPath method joins the path, element index or key is not dotted.
+func joinElemPath(prefix, path string) string {
+	if strings.HasPrefix(path, "[") {
+		return prefix + path
+	}
+
+	return joinPath(prefix, path)
+}
@@ -0,0 +1,338 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSentinelErrors(t *testing.T) {
+	type Src struct {
+		Name  string
+		Count string
+	}
+
+	type Dst struct {
+		Name  string
+		Count int
+	}
+
+	src := Src{Name: "go-model", Count: "1"}
+
+	errs := Copy(Dst{}, src)
+	assertEqual(t, true, errors.Is(errs[0], ErrNotPointer))
+	assertEqual(t, "Destination struct is not a pointer", errs[0].Error())
+
+	errs = Copy(&Dst{}, nil)
+	assertEqual(t, true, errors.Is(errs[0], ErrNilInput))
+
+	errs = Copy(&Dst{}, "go-model")
+	assertEqual(t, true, errors.Is(errs[0], ErrNotStruct))
+
+	errs = Copy(&Dst{}, Src{})
+	assertEqual(t, true, errors.Is(errs[0], ErrEmptyStruct))
+
+	var dst Dst
+	errs = Copy(&dst, src)
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, true, errors.Is(errs[0], ErrTypeMismatch))
+	assertEqual(t, false, errors.Is(errs[0], ErrFieldNotFound))
+
+	_, err := Get(src, "Missing")
+	assertEqual(t, true, errors.Is(err, ErrFieldNotFound))
+	assertEqual(t, "Field: 'Missing', does not exists", err.Error())
+
+	err = Set(&dst, "Missing", 1)
+	assertEqual(t, true, errors.Is(err, ErrFieldNotFound))
+
+	err = Set(&dst, "Count", "1")
+	assertEqual(t, true, errors.Is(err, ErrTypeMismatch))
+
+	_, err = Kind(src, "Missing")
+	assertEqual(t, true, errors.Is(err, ErrFieldNotFound))
+}
+
+func TestSentinelErrorsOfFromMap(t *testing.T) {
+	type Dst struct {
+		Count   int
+		Timeout time.Duration
+		Code    sampleTextCode
+		Name    string `model:",required"`
+		Meta    map[int]string
+	}
+
+	var dst Dst
+	errs := FromMap(&dst, map[string]interface{}{"Count": []string{"one"}})
+	assertEqual(t, true, errors.Is(errs[0], ErrTypeMismatch))
+	assertEqual(t, "Field: 'Count', type [[]string] is not assignable to [int]", errs[0].Error())
+
+	// cause is wrapped along with sentinel
+	errs = FromMap(&dst, map[string]interface{}{"Count": "one"})
+	var ne *strconv.NumError
+	assertEqual(t, true, errors.Is(errs[0], ErrTypeMismatch))
+	assertEqual(t, true, errors.As(errs[0], &ne))
+
+	errs = FromMap(&dst, map[string]interface{}{"Timeout": "soon"})
+	assertEqual(t, true, errors.Is(errs[0], ErrTypeMismatch))
+
+	errs = FromMap(&dst, map[string]interface{}{"Code": "ORD"}, WithTextMarshaler())
+	assertEqual(t, true, errors.Is(errs[0], ErrTypeMismatch))
+	assertEqual(t, "Field: 'Code', invalid code", errs[0].Error())
+
+	errs = FromMap(&dst, map[string]interface{}{"Meta": map[string]interface{}{"one": "1"}})
+	assertEqual(t, true, errors.Is(errs[0], ErrTypeMismatch))
+
+	errs = FromStringMap(&dst, map[string]string{"Count": "one"})
+	assertEqual(t, true, errors.Is(errs[0], ErrTypeMismatch))
+
+	errs = Unflatten(&dst, map[string]interface{}{"Missing.Name": "x", "Count": "one"})
+	assertEqual(t, true, errors.Is(errs[0], ErrTypeMismatch))
+	assertEqual(t, true, errors.Is(errs[1], ErrFieldNotFound))
+
+	errs = Validate(dst)
+	assertEqual(t, true, errors.Is(errs[0], ErrRequired))
+	assertEqual(t, "Field: 'Name', is required", errs[0].Error())
+
+	errs = Transform(&dst, func(f reflect.StructField) bool { return f.Name == "Count" },
+		func(v reflect.Value) (reflect.Value, error) { return valueOf("one"), nil })
+	assertEqual(t, true, errors.Is(errs[0], ErrTypeMismatch))
+}
+
+func TestSentinelErrorsOfSliceAndCycle(t *testing.T) {
+	type Node struct {
+		Name string
+		Next *Node
+	}
+
+	n := &Node{Name: "go-model"}
+	n.Next = n
+
+	var dsts []Node
+	var dstm map[string]int
+
+	_, mapErr := Map(n)
+	_, flattenErr := Flatten(n)
+	_, sliceErr := MapSlice("go-model")
+	_, elemErr := MapSlice([]interface{}{"go-model"})
+	_, pathErr := Get(n, "Next[0")
+
+	testcases := []struct {
+		name     string
+		err      error
+		sentinel error
+	}{
+		{"CopySlice", CopySlice(&dsts, "go-model")[0], ErrTypeMismatch},
+		{"CopyMap", Copy(&dstm, "go-model")[0], ErrTypeMismatch},
+		{"MapSlice", sliceErr, ErrTypeMismatch},
+		{"MapSliceElement", elemErr, ErrNotStruct},
+		{"Map", mapErr, ErrCycle},
+		{"Flatten", flattenErr, ErrCycle},
+		{"Validate", Validate(n)[0], ErrCycle},
+		{"InvalidPath", pathErr, ErrFieldNotFound},
+	}
+
+	for _, tc := range testcases {
+		assertEqual(t, tc.name+": true", tc.name+": "+strconv.FormatBool(errors.Is(tc.err, tc.sentinel)))
+	}
+
+	var fe *FieldError
+	assertEqual(t, true, errors.As(mapErr, &fe))
+	assertEqual(t, "Next", fe.Path)
+}
+
+func TestFieldError(t *testing.T) {
+	type Src struct {
+		Name  string
+		Count string
+	}
+
+	type Dst struct {
+		Name  string
+		Count int
+	}
+
+	var dst Dst
+	errs := Copy(&dst, Src{Name: "go-model", Count: "1"})
+	assertEqual(t, 1, len(errs))
+
+	var fe *FieldError
+	assertEqual(t, true, errors.As(errs[0], &fe))
+	assertEqual(t, "Count", fe.Path)
+	assertEqual(t, true, fe.SrcType == reflect.TypeOf(""))
+	assertEqual(t, true, fe.DstType == reflect.TypeOf(0))
+	assertEqual(t, "src [string] & dst [int] kind didn't match", fe.Reason)
+	assertEqual(t, "Field: 'Count', src [string] & dst [int] kind didn't match", fe.Error())
+	assertEqual(t, true, errors.Is(fe, ErrTypeMismatch))
+
+	// field converter error is the cause
+	errInvalid := errors.New("invalid count")
+	type ConvDst struct {
+		Count int `model:",conv=sampleFieldErrorCount"`
+	}
+
+	RegisterNamedConverter("sampleFieldErrorCount", func(in reflect.Value) (reflect.Value, error) {
+		return reflect.Value{}, errInvalid
+	})
+	defer RemoveNamedConverter("sampleFieldErrorCount")
+
+	var cdst ConvDst
+	errs = Copy(&cdst, Src{Name: "go-model", Count: "1"})
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, true, errors.As(errs[0], &fe))
+	assertEqual(t, "Count", fe.Path)
+	assertEqual(t, true, errors.Is(errs[0], errInvalid))
+	assertEqual(t, "Field: 'Count', invalid count", errs[0].Error())
+}
+
+func TestFieldErrorNestedPath(t *testing.T) {
+	type Level2 struct {
+		Name  string
+		Count string `model:",conv=sampleNestedPathCount"`
+	}
+
+	type Level1 struct {
+		Name   string
+		Level2 Level2
+	}
+
+	type Src struct {
+		SampleEmbedBase
+		Level1 Level1
+	}
+
+	type Dst struct {
+		SampleEmbedBase
+		Level1 Level1
+	}
+
+	RegisterNamedConverter("sampleNestedPathCount", func(in reflect.Value) (reflect.Value, error) {
+		return reflect.Value{}, errors.New("invalid count")
+	})
+	defer RemoveNamedConverter("sampleNestedPathCount")
+
+	src := Src{
+		SampleEmbedBase: SampleEmbedBase{ID: 1},
+		Level1:          Level1{Name: "level1", Level2: Level2{Name: "level2", Count: "one"}},
+	}
+
+	var dst Dst
+	errs := Copy(&dst, src)
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, "Field: 'Level1.Level2.Count', invalid count", errs[0].Error())
+	assertEqual(t, "level2", dst.Level1.Level2.Name)
+
+	var fe *FieldError
+	assertEqual(t, true, errors.As(errs[0], &fe))
+	assertEqual(t, "Level1.Level2.Count", fe.Path)
+
+	// type converter error is reported with field path
+	type Price struct {
+		Amount string
+	}
+
+	type Item struct {
+		Price Price
+	}
+
+	type DstItem struct {
+		Price float64
+	}
+
+	AddConversion((*Price)(nil), (*float64)(nil), func(in reflect.Value) (reflect.Value, error) {
+		return reflect.Value{}, errors.New("invalid price")
+	})
+	defer RemoveConversion((*Price)(nil), (*float64)(nil))
+
+	var ditem DstItem
+	errs = Copy(&ditem, Item{Price: Price{Amount: "ten"}})
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, "Field: 'Price', invalid price", errs[0].Error())
+}
+
+func TestCopyE(t *testing.T) {
+	type Src struct {
+		Name  string
+		Count string
+		Flag  string
+	}
+
+	type Dst struct {
+		Name  string
+		Count int
+		Flag  bool
+	}
+
+	var dst Dst
+	err := CopyE(&dst, Src{Name: "go-model", Count: "1", Flag: "true"})
+	assertEqual(t, true, err != nil)
+	assertEqual(t, true, errors.Is(err, ErrTypeMismatch))
+	assertEqual(t, "Field: 'Count', src [string] & dst [int] kind didn't match\n"+
+		"Field: 'Flag', src [string] & dst [bool] kind didn't match", err.Error())
+	assertEqual(t, "go-model", dst.Name)
+
+	var fe *FieldError
+	assertEqual(t, true, errors.As(err, &fe))
+	assertEqual(t, "Count", fe.Path)
+
+	err = CopyE(&dst, Src{Name: "go-model"}, WithWeakTyping())
+	assertError(t, err)
+
+	err = CopyE(dst, Src{Name: "go-model"})
+	assertEqual(t, true, errors.Is(err, ErrNotPointer))
+}
+
+func TestFieldErrorElementPath(t *testing.T) {
+	type Line struct {
+		SKU   string
+		Count string `model:",conv=sampleElementPathCount"`
+	}
+
+	type Order struct {
+		Lines  []Line
+		ByCode map[string]Line
+		Pairs  [2]Line
+	}
+
+	RegisterNamedConverter("sampleElementPathCount", func(in reflect.Value) (reflect.Value, error) {
+		if in.String() == "bad" {
+			return reflect.Value{}, errors.New("invalid count")
+		}
+		return in, nil
+	})
+	defer RemoveNamedConverter("sampleElementPathCount")
+
+	src := Order{
+		Lines:  []Line{{SKU: "a", Count: "1"}, {SKU: "b", Count: "bad"}},
+		ByCode: map[string]Line{"x": {SKU: "x", Count: "bad"}},
+		Pairs:  [2]Line{{SKU: "p", Count: "bad"}, {SKU: "q", Count: "2"}},
+	}
+
+	var dst Order
+	errs := Copy(&dst, src)
+	assertEqual(t, 3, len(errs))
+	assertEqual(t, "Field: 'Lines[1].Count', invalid count", errs[0].Error())
+	assertEqual(t, "Field: 'ByCode[x].Count', invalid count", errs[1].Error())
+	assertEqual(t, "Field: 'Pairs[0].Count', invalid count", errs[2].Error())
+
+	// element is copied regardless of the failed field
+	assertEqual(t, 2, len(dst.Lines))
+	assertEqual(t, "b", dst.Lines[1].SKU)
+	assertEqual(t, "x", dst.ByCode["x"].SKU)
+	assertEqual(t, "q", dst.Pairs[1].SKU)
+
+	var fe *FieldError
+	assertEqual(t, true, errors.As(errs[0], &fe))
+	assertEqual(t, "Lines[1].Count", fe.Path)
+
+	// root slice
+	var lines []Line
+	errs = CopySlice(&lines, src.Lines)
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, "Field: '[1].Count', invalid count", errs[0].Error())
+}
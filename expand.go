@@ -0,0 +1,196 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Splitter is used to split the composite string into n parts for "expand" option.
+type Splitter func(s string, n int) []string
+
+// Joiner is used to join the parts into composite string for "expand" option,
+// it's the reverse of `Splitter`.
+type Joiner func(parts []string) string
+
+type expander struct {
+	split Splitter
+	join  Joiner
+}
+
+var (
+	// Expanders at library level, default one splits by whitespace
+	expanderMap = map[string]expander{}
+
+	defaultExpander = expander{split: splitBySpace, join: joinBySpace}
+)
+
+// AddExpander method registers the `Splitter` and `Joiner` pair by name, which can be
+// chosen via "expander" option along with "expand" option. See also `RemoveExpander()`.
+// 		Example:
+//
+// 		model.AddExpander("csv",
+// 			func(s string, n int) []string { return strings.SplitN(s, ",", n) },
+// 			func(parts []string) string { return strings.Join(parts, ",") })
+//
+// 		// source "Chennai,TN" is copied into destination fields City and State
+// 		Location	string	`model:"location,expand=City State,expander=csv"`
+//
+func AddExpander(name string, splitter Splitter, joiner Joiner) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	expanderMap[name] = expander{split: splitter, join: joiner}
+}
+
+// RemoveExpander method removes the registered `Splitter` and `Joiner` pair by name.
+func RemoveExpander(name string) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	delete(expanderMap, name)
+}
+
+// expandField method splits the composite source string into the destination
+// fields listed in "expand" option.
+func expandField(dv, sfv reflect.Value, f reflect.StructField, t *tag, names []string) []error {
+	e, err := expanderOf(t)
+	if err != nil {
+		return []error{newFieldError(f.Name, sfv.Type(), nil, err, "%v", err)}
+	}
+
+	sfv = indirect(sfv)
+	if sfv.Kind() != reflect.String {
+		return []error{newFieldError(f.Name, sfv.Type(), nil, ErrTypeMismatch, "expand option supports only string")}
+	}
+
+	var errs []error
+	parts := e.split(sfv.String(), len(names))
+	for i, name := range names {
+		dfv, found := lookupField(dv, name, "", true)
+		if !found {
+			continue
+		}
+
+		var part string
+		if i < len(parts) {
+			part = parts[i]
+		}
+
+		if err := assignValue(dfv, valueOf(part)); err != nil {
+			errs = append(errs, newFieldError(name, typeOfString, dfv.Type(), err, "%v", err))
+		}
+	}
+
+	return errs
+}
+
+// joinExpandFields method joins the source fields into the destination field
+// which has "expand" option. It's applicable only if source does not have the
+// destination field itself and field is within the field mask.
+func joinExpandFields(dv, sv reflect.Value, o *options) []error {
+	var errs []error
+
+	for _, f := range modelFields(dv) {
+		tag := o.tagOf(f)
+		if tag.isOmitField() {
+			continue
+		}
+
+		if _, included := o.mask.child(f, tag); !included {
+			continue
+		}
+
+		names, found := tag.expandFields()
+		if !found {
+			continue
+		}
+
+		if _, exists := lookupField(sv, f.Name, "", false); exists {
+			continue
+		}
+
+		e, err := expanderOf(tag)
+		if err != nil {
+			errs = append(errs, newFieldError(f.Name, nil, f.Type, err, "%v", err))
+			continue
+		}
+
+		var (
+			parts []string
+			isVal bool
+		)
+		for _, name := range names {
+			sfv, exists := lookupField(sv, name, "", false)
+			if !exists {
+				parts = append(parts, "")
+				continue
+			}
+
+			isVal = true
+			parts = append(parts, stringOf(sfv))
+		}
+
+		if !isVal {
+			continue
+		}
+
+		if err := assignValue(dv.FieldByIndex(f.Index), valueOf(e.join(parts))); err != nil {
+			errs = append(errs, newFieldError(f.Name, typeOfString, f.Type, err, "%v", err))
+		}
+	}
+
+	return errs
+}
+
+func expanderOf(t *tag) (expander, error) {
+	name, found := t.option(Expander)
+	if !found {
+		return defaultExpander, nil
+	}
+
+	registryMu.RLock()
+	e, found := expanderMap[name]
+	registryMu.RUnlock()
+	if !found {
+		return expander{}, fmt.Errorf("expander '%v' is not registered", name)
+	}
+
+	return e, nil
+}
+
+func splitBySpace(s string, n int) []string {
+	parts := strings.Fields(s)
+	if len(parts) <= n || n <= 0 {
+		return parts
+	}
+
+	// remaining parts goes to last one
+	return append(parts[:n-1], strings.Join(parts[n-1:], " "))
+}
+
+func joinBySpace(parts []string) string {
+	var nonEmpty []string
+	for _, p := range parts {
+		if !isStringEmpty(p) {
+			nonEmpty = append(nonEmpty, p)
+		}
+	}
+
+	return strings.Join(nonEmpty, " ")
+}
+
+func stringOf(v reflect.Value) string {
+	for isPtr(v) || isInterface(v) {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+
+	return fmt.Sprintf("%v", v.Interface())
+}
@@ -0,0 +1,78 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCopyExpandField(t *testing.T) {
+	type Legacy struct {
+		FullName string `model:"fullName,expand=FirstName LastName"`
+		Location string `model:",expand=City State Zip,expander=csv"`
+		Age      int
+	}
+
+	type Person struct {
+		FirstName string
+		LastName  string
+		City      string
+		State     string
+		Zip       *int
+		Age       int
+	}
+
+	AddExpander("csv",
+		func(s string, n int) []string { return strings.SplitN(s, ",", n) },
+		func(parts []string) string { return strings.Join(parts, ",") })
+	defer RemoveExpander("csv")
+
+	src := Legacy{FullName: "Jeevanandam M Kumar", Location: "Chennai,TN,600001", Age: 30}
+	dst := Person{}
+
+	errs := Copy(&dst, src)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "Jeevanandam", dst.FirstName)
+	assertEqual(t, "M Kumar", dst.LastName)
+	assertEqual(t, "Chennai", dst.City)
+	assertEqual(t, "TN", dst.State)
+	assertEqual(t, 600001, *dst.Zip)
+	assertEqual(t, 30, dst.Age)
+
+	// reverse direction joins
+	legacy := Legacy{}
+	errs = Copy(&legacy, dst)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "Jeevanandam M Kumar", legacy.FullName)
+	assertEqual(t, "Chennai,TN,600001", legacy.Location)
+	assertEqual(t, 30, legacy.Age)
+}
+
+func TestCopyExpandFieldErrors(t *testing.T) {
+	type Legacy struct {
+		FullName string `model:",expand=FirstName LastName,expander=unknown"`
+		Count    int    `model:",expand=FirstName"`
+		Name     string `model:",expand=Age"`
+	}
+
+	type Person struct {
+		FirstName string
+		Age       int
+	}
+
+	dst := Person{}
+	errs := Copy(&dst, Legacy{FullName: "Jeeva", Count: 1, Name: "abc"})
+	assertEqual(t, 3, len(errs))
+	assertEqual(t, "Field: 'FullName', expander 'unknown' is not registered", errs[0].Error())
+	assertEqual(t, "Field: 'Count', expand option supports only string", errs[1].Error())
+	assertEqual(t, true, strings.HasPrefix(errs[2].Error(), "Field: 'Age', strconv.ParseInt"))
+}
+
+func TestSplitJoinBySpace(t *testing.T) {
+	assertEqual(t, []string{"a", "b c"}, splitBySpace(" a  b c ", 2))
+	assertEqual(t, []string{"a"}, splitBySpace("a", 2))
+	assertEqual(t, "a c", joinBySpace([]string{"a", "", "c"}))
+}
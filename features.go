@@ -0,0 +1,49 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+// Feature names reported by `Features()` method
+const (
+	FeatureStructuralMatching = "structuralMatching"
+	FeatureGenerics           = "generics"
+	FeatureCompiledPlans      = "compiledPlans"
+	FeatureCallOptions        = "callOptions"
+	FeatureValidate           = "validate"
+	FeatureRedact             = "redact"
+	FeatureFlatten            = "flatten"
+	FeatureStringMap          = "stringMap"
+	FeatureCycleSafeCopy      = "cycleSafeCopy"
+)
+
+// features keeps track of optional capabilities present in the library
+var features = map[string]bool{
+	FeatureStructuralMatching: false,
+	FeatureGenerics:           true,
+	FeatureCompiledPlans:      true,
+	FeatureCallOptions:        true,
+	FeatureValidate:           true,
+	FeatureRedact:             true,
+	FeatureFlatten:            true,
+	FeatureStringMap:          true,
+	FeatureCycleSafeCopy:      true,
+}
+
+// Features method returns the optional capabilities of go-model library, so
+// downstream libraries can detect them along with `Version` and degrade
+// gracefully. Unknown feature name reads as `false` from the result.
+// 		Example:
+//
+// 		if model.Features()[model.FeatureCompiledPlans] {
+// 			// use compiled copy plans
+// 		}
+//
+func Features() map[string]bool {
+	fs := make(map[string]bool, len(features))
+	for name, present := range features {
+		fs[name] = present
+	}
+
+	return fs
+}
@@ -0,0 +1,22 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import "testing"
+
+func TestFeatures(t *testing.T) {
+	fs := Features()
+	assertEqual(t, true, fs[FeatureValidate])
+	assertEqual(t, true, fs[FeatureFlatten])
+	assertEqual(t, true, fs[FeatureCycleSafeCopy])
+	assertEqual(t, false, fs["unknownFeature"])
+
+	_, found := fs[FeatureCompiledPlans]
+	assertEqual(t, true, found)
+
+	// result is a copy, modification does not affect library
+	fs[FeatureValidate] = false
+	assertEqual(t, true, Features()[FeatureValidate])
+}
@@ -0,0 +1,205 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// fieldConverterKey is the destination struct type and it's field name.
+type fieldConverterKey struct {
+	typ  reflect.Type
+	name string
+}
+
+var (
+	// Field scoped type conversion functions at library level
+	fieldConverterMap = map[fieldConverterKey]Converter{}
+
+	// Named conversion functions at library level, chosen via "conv" option
+	namedConverterMap = map[string]Converter{}
+)
+
+// AddFieldConversion method allows registering a custom `Converter` for the specific
+// field of destination `struct`, rather than for the whole datatype pair. So the two
+// fields of same type in a struct can be converted differently. Field path is the
+// field name, nested struct field is separated by dot. Field converter takes
+// precedence over the type converters.
+// 		Example:
+//
+// 		model.AddFieldConversion(Order{}, "CreatedAt", func(in reflect.Value) (reflect.Value, error) {
+// 			return reflect.ValueOf(in.Interface().(time.Time).Unix()), nil
+// 		})
+//
+// 		// nested struct field
+// 		model.AddFieldConversion(Order{}, "Shipment.DeliveredAt", deliveredAtConverter)
+//
+// Note: Field converter is registered on the struct which declares the field, for eg.:
+// "Shipment.DeliveredAt" is applied wherever `Shipment` struct is copied into.
+//
+func AddFieldConversion(dst interface{}, path string, converter Converter) error {
+	key, err := fieldConverterKeyOf(dst, path)
+	if err != nil {
+		return err
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	fieldConverterMap[key] = converter
+	return nil
+}
+
+// RemoveFieldConversion method removes the registered field converter.
+func RemoveFieldConversion(dst interface{}, path string) {
+	key, err := fieldConverterKeyOf(dst, path)
+	if err != nil {
+		return
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	delete(fieldConverterMap, key)
+}
+
+// RegisterNamedConverter method registers the `Converter` by name, which can be chosen
+// per field via "conv" option. So the conversion policy stays next to the model
+// definition. The option is applicable on either source or destination field,
+// source field option takes precedence. See also `RemoveNamedConverter()`.
+// 		Example:
+//
+// 		model.RegisterNamedConverter("unixtime", func(in reflect.Value) (reflect.Value, error) {
+// 			return reflect.ValueOf(in.Interface().(time.Time).Unix()), nil
+// 		})
+//
+// 		// destination struct
+// 		CreatedAt	int64	`model:"created,conv=unixtime"`
+//
+// Named converter is applied by `Copy()` method on non-zero field value, field converter
+// registered via `AddFieldConversion()` takes precedence. `Map()` method applies it on
+// field value with "conv" option and the actual value is mapped, if the conversion fails.
+//
+func RegisterNamedConverter(name string, converter Converter) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	namedConverterMap[name] = converter
+}
+
+// RemoveNamedConverter method removes the registered named converter.
+func RemoveNamedConverter(name string) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	delete(namedConverterMap, name)
+}
+
+// namedConverterOf method returns the registered named converter.
+func namedConverterOf(name string) (Converter, error) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	c, found := namedConverterMap[name]
+	if !found {
+		return nil, fmt.Errorf("converter '%v' is not registered", name)
+	}
+
+	return c, nil
+}
+
+// fieldConverterKeyOf method resolves the field path into the struct type which
+// declares the field.
+func fieldConverterKeyOf(dst interface{}, path string) (fieldConverterKey, error) {
+	if dst == nil {
+		return fieldConverterKey{}, newError(ErrNilInput, "Invalid input <nil>")
+	}
+
+	t := structTypeOf(dst)
+	if t == nil {
+		return fieldConverterKey{}, newError(ErrNotStruct, "Input is not a struct")
+	}
+
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		f, found := structField(t, name)
+		if !found {
+			return fieldConverterKey{}, newError(ErrFieldNotFound, "Field: '%v', does not exists", path)
+		}
+
+		if i == len(names)-1 {
+			return fieldConverterKey{typ: fieldOwner(t, f.Index), name: f.Name}, nil
+		}
+
+		t = indirectType(f.Type)
+		if t.Kind() != reflect.Struct {
+			return fieldConverterKey{}, newError(ErrNotStruct, "Field: '%v', is not a struct", joinPath(strings.Join(names[:i], "."), name))
+		}
+	}
+
+	return fieldConverterKey{}, newError(ErrFieldNotFound, "Field: '%v', does not exists", path)
+}
+
+// fieldOwner method returns the struct type which declares the field of given
+// index sequence, promoted field is declared by embedded struct.
+func fieldOwner(t reflect.Type, index []int) reflect.Type {
+	for _, i := range index[:len(index)-1] {
+		t = indirectType(t.Field(i).Type)
+	}
+
+	return t
+}
+
+// fieldConverterOf method returns the registered field converter.
+func fieldConverterOf(t reflect.Type, name string) Converter {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	if len(fieldConverterMap) == 0 {
+		return nil
+	}
+
+	return fieldConverterMap[fieldConverterKey{typ: t, name: name}]
+}
+
+// convertField method applies the field converter and sets the result into
+// destination field.
+func convertField(dfv, sfv reflect.Value, name string, converter Converter) error {
+	v, err := converter(sfv)
+	if err != nil {
+		return newFieldError(name, sfv.Type(), dfv.Type(), err, "%v", err)
+	}
+
+	if !v.IsValid() {
+		dfv.Set(reflect.Zero(dfv.Type()))
+		return nil
+	}
+
+	if !v.Type().AssignableTo(dfv.Type()) {
+		return newFieldError(name, sfv.Type(), dfv.Type(), ErrTypeMismatch,
+			"converted [%v] is not assignable to [%v]", v.Type(), dfv.Type())
+	}
+
+	dfv.Set(v)
+	return nil
+}
+
+// mapConverted method applies the named converter on field value for `Map()`
+// method, it reports whether the conversion succeeded.
+func mapConverted(fv reflect.Value, name string) (interface{}, bool) {
+	c, err := namedConverterOf(name)
+	if err != nil {
+		return nil, false
+	}
+
+	v, err := c(fv)
+	if err != nil || !v.IsValid() {
+		return nil, false
+	}
+
+	return v.Interface(), true
+}
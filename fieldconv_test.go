@@ -0,0 +1,145 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type FieldConvAudit struct {
+	UpdatedAt time.Time
+}
+
+type fieldConvShipment struct {
+	DeliveredAt time.Time
+}
+
+type fieldConvOrder struct {
+	FieldConvAudit
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	Shipment  *fieldConvShipment
+}
+
+type fieldConvOrderDto struct {
+	FieldConvAudit
+	CreatedAt int64
+	ExpiresAt string
+	Shipment  *fieldConvShipment
+}
+
+func TestAddFieldConversion(t *testing.T) {
+	unix := func(in reflect.Value) (reflect.Value, error) {
+		return reflect.ValueOf(in.Interface().(time.Time).Unix()), nil
+	}
+	date := func(in reflect.Value) (reflect.Value, error) {
+		return reflect.ValueOf(in.Interface().(time.Time).Format("2006-01-02")), nil
+	}
+	nextDay := func(in reflect.Value) (reflect.Value, error) {
+		return reflect.ValueOf(in.Interface().(time.Time).AddDate(0, 0, 1)), nil
+	}
+
+	assertError(t, AddFieldConversion(fieldConvOrderDto{}, "CreatedAt", unix))
+	assertError(t, AddFieldConversion(&fieldConvOrderDto{}, "ExpiresAt", date))
+	assertError(t, AddFieldConversion(fieldConvOrderDto{}, "Shipment.DeliveredAt", nextDay))
+	assertError(t, AddFieldConversion(fieldConvOrderDto{}, "UpdatedAt", nextDay))
+	defer func() {
+		RemoveFieldConversion(fieldConvOrderDto{}, "CreatedAt")
+		RemoveFieldConversion(fieldConvOrderDto{}, "ExpiresAt")
+		RemoveFieldConversion(fieldConvOrderDto{}, "Shipment.DeliveredAt")
+		RemoveFieldConversion(fieldConvOrderDto{}, "UpdatedAt")
+	}()
+
+	now := time.Date(2016, 8, 1, 10, 0, 0, 0, time.UTC)
+	src := fieldConvOrder{
+		FieldConvAudit: FieldConvAudit{UpdatedAt: now},
+		CreatedAt:      now,
+		ExpiresAt:      now,
+		Shipment:       &fieldConvShipment{DeliveredAt: now},
+	}
+
+	dst := fieldConvOrderDto{}
+	errs := Copy(&dst, src)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, now.Unix(), dst.CreatedAt)
+	assertEqual(t, "2016-08-01", dst.ExpiresAt)
+	assertEqual(t, true, dst.Shipment.DeliveredAt.Equal(now.AddDate(0, 0, 1)))
+	assertEqual(t, true, dst.UpdatedAt.Equal(now.AddDate(0, 0, 1)))
+
+	// zero value is not converted
+	dst = fieldConvOrderDto{CreatedAt: 10}
+	errs = Copy(&dst, fieldConvOrder{ExpiresAt: now})
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, int64(0), dst.CreatedAt)
+
+	// converter error and result type
+	assertError(t, AddFieldConversion(fieldConvOrderDto{}, "CreatedAt", func(in reflect.Value) (reflect.Value, error) {
+		return reflect.Value{}, errors.New("invalid time")
+	}))
+	assertError(t, AddFieldConversion(fieldConvOrderDto{}, "ExpiresAt", unix))
+	errs = Copy(&fieldConvOrderDto{}, src)
+	assertEqual(t, 2, len(errs))
+	assertEqual(t, "Field: 'CreatedAt', invalid time", errs[0].Error())
+	assertEqual(t, "Field: 'ExpiresAt', converted [int64] is not assignable to [string]", errs[1].Error())
+
+	// invalid input
+	assertEqual(t, "Invalid input <nil>", AddFieldConversion(nil, "CreatedAt", unix).Error())
+	assertEqual(t, "Input is not a struct", AddFieldConversion("", "CreatedAt", unix).Error())
+	assertEqual(t, "Field: 'NotExists', does not exists", AddFieldConversion(fieldConvOrderDto{}, "NotExists", unix).Error())
+	assertEqual(t, "Field: 'CreatedAt', is not a struct", AddFieldConversion(fieldConvOrderDto{}, "CreatedAt.Day", unix).Error())
+}
+
+func TestRegisterNamedConverter(t *testing.T) {
+	RegisterNamedConverter("unixtime", func(in reflect.Value) (reflect.Value, error) {
+		return reflect.ValueOf(in.Interface().(time.Time).Unix()), nil
+	})
+	RegisterNamedConverter("date", func(in reflect.Value) (reflect.Value, error) {
+		return reflect.ValueOf(in.Interface().(time.Time).Format("2006-01-02")), nil
+	})
+	defer RemoveNamedConverter("unixtime")
+	defer RemoveNamedConverter("date")
+
+	type Event struct {
+		CreatedAt time.Time `model:"created,conv=date"`
+		UpdatedAt time.Time
+		Title     string `model:",conv=unknown"`
+	}
+
+	type EventDto struct {
+		CreatedAt string
+		UpdatedAt int64 `model:"updated,conv=unixtime"`
+		Title     string
+	}
+
+	now := time.Date(2016, 8, 1, 10, 0, 0, 0, time.UTC)
+	src := Event{CreatedAt: now, UpdatedAt: now, Title: "go-model"}
+
+	// source option on CreatedAt, destination option on UpdatedAt
+	dst := EventDto{}
+	errs := Copy(&dst, src)
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, "Field: 'Title', converter 'unknown' is not registered", errs[0].Error())
+	assertEqual(t, "2016-08-01", dst.CreatedAt)
+	assertEqual(t, now.Unix(), dst.UpdatedAt)
+
+	m, err := Map(src)
+	assertError(t, err)
+	assertEqual(t, "2016-08-01", m["created"])
+	assertEqual(t, true, m["UpdatedAt"].(time.Time).Equal(now))
+	assertEqual(t, "go-model", m["Title"])
+
+	// field converter takes precedence
+	assertError(t, AddFieldConversion(EventDto{}, "CreatedAt", func(in reflect.Value) (reflect.Value, error) {
+		return reflect.ValueOf("field"), nil
+	}))
+	defer RemoveFieldConversion(EventDto{}, "CreatedAt")
+
+	dst = EventDto{}
+	_ = Copy(&dst, src)
+	assertEqual(t, "field", dst.CreatedAt)
+}
@@ -0,0 +1,175 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"time"
+)
+
+// FillOption is used to customize `Fill()` method.
+type FillOption func(o *fillOptions)
+
+type fillOptions struct {
+	rand     *rand.Rand
+	length   int
+	maxDepth int
+}
+
+// WithFillSeed option makes `Fill()` method generate the same sample values
+// for given seed. Default seed is 1, so values are deterministic by default.
+func WithFillSeed(seed int64) FillOption {
+	return func(o *fillOptions) {
+		o.rand = rand.New(rand.NewSource(seed))
+	}
+}
+
+// WithFillRandom option makes `Fill()` method generate different sample values
+// on every call.
+func WithFillRandom() FillOption {
+	return WithFillSeed(time.Now().UnixNano())
+}
+
+// WithFillLength option sets the number of elements generated for slice and
+// map fields. Default is 2.
+func WithFillLength(n int) FillOption {
+	return func(o *fillOptions) {
+		o.length = n
+	}
+}
+
+// WithFillMaxDepth option sets how deep the nested struct pointers are filled,
+// it stops the self referencing types. Default is 3.
+func WithFillMaxDepth(n int) FillOption {
+	return func(o *fillOptions) {
+		o.maxDepth = n
+	}
+}
+
+// fillBaseTime is the base of generated `time.Time` values.
+var fillBaseTime = time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// Fill method populates the exported fields of given `struct` pointer with sample
+// values, it's handy for table tests and fixtures. Values are deterministic by
+// default, see `WithFillSeed()` and `WithFillRandom()` options.
+// 		Example:
+//
+// 		user := User{}
+// 		err := model.Fill(&user, model.WithFillLength(3))
+// 		fmt.Println("Error:", err)
+//
+// 		// Output:
+// 		{Name:Name81 Age:88 Emails:[Emails47 Emails59 Emails81] ...}
+//
+// Generated values:
+// [1] string is the field name suffixed with a number; numbers are between 1 and 100.
+// [2] `time.Time` is a time in 2016, `time.Duration` is up to an hour.
+// [3] Pointer, slice and map fields are allocated and filled.
+// [4] Interface, channel, func fields and the values holding handle are left as is.
+//
+// A "model" tag with the value of "-" is ignored by library for processing.
+//
+// A "model" tag value with the option of "notraverse"; library will not traverse
+// inside the struct object, it's left as is.
+//
+func Fill(s interface{}, opts ...FillOption) error {
+	sv, err := destStructValue(s)
+	if err != nil {
+		return err
+	}
+
+	o := &fillOptions{length: 2, maxDepth: 3}
+	WithFillSeed(1)(o)
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	o.fillStruct(sv, 0)
+	return nil
+}
+
+func (o *fillOptions) fillStruct(sv reflect.Value, depth int) {
+	for _, f := range modelFields(sv) {
+		fv := sv.FieldByIndex(f.Index)
+		tag := newTag(f.Tag.Get(TagName))
+
+		if tag.isOmitField() || tag.isNoTraverse() || !fv.CanSet() {
+			continue
+		}
+
+		o.fillVal(fv, f.Name, depth)
+	}
+}
+
+func (o *fillOptions) fillVal(v reflect.Value, name string, depth int) {
+	t := v.Type()
+	if _, found := handlePolicyOf(t); found {
+		return
+	}
+
+	switch t {
+	case typeOfTime:
+		v.Set(valueOf(fillBaseTime.Add(time.Duration(o.rand.Intn(365*24)) * time.Hour)))
+		return
+	case typeOfDuration:
+		v.SetInt(int64(time.Duration(1+o.rand.Intn(3600)) * time.Second))
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		v.SetString(fmt.Sprintf("%v%d", name, o.number()))
+	case reflect.Bool:
+		v.SetBool(o.rand.Intn(2) == 1)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(int64(o.number()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v.SetUint(uint64(o.number()))
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(float64(o.number()) + float64(o.rand.Intn(100))/100)
+	case reflect.Ptr:
+		if t.Elem().Kind() == reflect.Struct && depth >= o.maxDepth {
+			return
+		}
+
+		pv := reflect.New(t.Elem())
+		o.fillVal(pv.Elem(), name, depth+1)
+		v.Set(pv)
+	case reflect.Struct:
+		if isNoTraverseType(v) {
+			return
+		}
+
+		o.fillStruct(v, depth)
+	case reflect.Slice:
+		sv := reflect.MakeSlice(t, o.length, o.length)
+		for i := 0; i < o.length; i++ {
+			o.fillVal(sv.Index(i), name, depth)
+		}
+		v.Set(sv)
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			o.fillVal(v.Index(i), name, depth)
+		}
+	case reflect.Map:
+		mv := reflect.MakeMapWithSize(t, o.length)
+		for i := 0; i < o.length; i++ {
+			kv := reflect.New(t.Key()).Elem()
+			o.fillVal(kv, name, depth)
+
+			ev := reflect.New(t.Elem()).Elem()
+			o.fillVal(ev, name, depth)
+			mv.SetMapIndex(kv, ev)
+		}
+		v.Set(mv)
+	}
+}
+
+// number method returns the sample number between 1 and 100.
+func (o *fillOptions) number() int {
+	return 1 + o.rand.Intn(100)
+}
@@ -0,0 +1,68 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFill(t *testing.T) {
+	type Address struct {
+		City    string
+		ZipCode *int
+	}
+
+	type Node struct {
+		Name string
+		Next *Node
+	}
+
+	type SampleStruct struct {
+		Name      string
+		Count     int8
+		Price     float64
+		Size      uint16
+		CreatedAt time.Time
+		Timeout   time.Duration
+		Address   Address
+		Billing   *Address
+		Tags      []string
+		Labels    map[string]int
+		Codes     [3]int
+		Node      Node
+		Secret    string          `model:"-"`
+		Region    Address         `model:",notraverse"`
+		Ctx       context.Context `model:"ctx"`
+	}
+
+	src := SampleStruct{}
+	err := Fill(&src)
+	assertError(t, err)
+	assertEqual(t, []string{"Node.Next.Next.Next.Next", "Region", "Ctx"}, ZeroFields(src))
+	assertEqual(t, true, strings.HasPrefix(src.Name, "Name"))
+	assertEqual(t, 2, len(src.Tags))
+	assertEqual(t, 2, len(src.Labels))
+	assertEqual(t, 2016, src.CreatedAt.Year())
+
+	// same seed generates same values
+	other := SampleStruct{}
+	err = Fill(&other)
+	assertError(t, err)
+	assertEqual(t, true, reflect.DeepEqual(src, other))
+
+	other = SampleStruct{}
+	err = Fill(&other, WithFillSeed(42), WithFillLength(4), WithFillMaxDepth(1))
+	assertError(t, err)
+	assertEqual(t, false, reflect.DeepEqual(src, other))
+	assertEqual(t, 4, len(other.Tags))
+	assertEqual(t, true, other.Node.Next.Next == nil)
+
+	err = Fill(src)
+	assertEqual(t, "Destination struct is not a pointer", err.Error())
+}
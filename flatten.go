@@ -0,0 +1,254 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Flatten method converts the given `struct` into flat `map[string]interface{}`
+// whose keys are dot-path of nested fields. Slice elements and map entries are
+// flattened with index notation.
+// 		Example:
+//
+// 		src := SampleStruct { /* source struct field values go here */ }
+//
+// 		m, _ := model.Flatten(src)
+// 		fmt.Println(m)
+//
+// 		// Output:
+// 		map[Level1.Level2.Name:go-model Items[0].ID:101 Meta[region]:IN]
+//
+// The key name of the field follows the same rule of `Map()` method, field name
+// or "model" tag name. Embedded struct fields are flattened at same level as
+// represented by Go. Nil pointer, empty slice and empty map are kept as-is.
+//
+// A "model" tag with the value of "-" is ignored by library for processing.
+//
+// A "model" tag value with the option of "omitempty"; library will not include
+// those values if it's empty/zero value.
+//
+// A "model" tag value with the option of "notraverse"; library will not traverse
+// inside the struct object, the value is included as-is.
+//
+// A "model" tag value with the option of "redact"; library handles the field value
+// as per `RedactMode`, see `Map()` method.
+//
+func Flatten(s interface{}, opts ...Option) (map[string]interface{}, error) {
+	sv, err := structValue(s)
+	if err != nil {
+		return nil, err
+	}
+
+	// root pointer is in traversal too, so nested back reference is detected
+	visiting := map[visitKey]bool{}
+	if v := valueOf(s); isPtr(v) {
+		visiting[visitKeyOf(v)] = true
+	}
+
+	m := map[string]interface{}{}
+	if err = flattenStruct(m, "", sv, visiting, newOptions(opts)); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Unflatten method is the inverse of `Flatten()` method. It populates the destination
+// `struct` from the given dot-path map. Nil pointers of nested struct, slice and map
+// are allocated along the way.
+// 		Example:
+//
+// 		m := map[string]interface{}{
+// 			"Level1.Level2.Name": "go-model",
+// 			"Items[0].ID":        101,
+// 			"Meta[region]":       "IN",
+// 		}
+//
+// 		dst := SampleStruct{}
+// 		errs := model.Unflatten(&dst, m)
+// 		if errs != nil {
+// 			fmt.Println("Errors:", errs)
+// 		}
+//
+// The value is assigned to the field with the registered `Converter` if exists, pointer
+// and non-pointer value is adapted for the field, and the string value is parsed into
+// the field type (see `FromStringMap()` method). Path segments are the key names
+// of the field, as `Flatten()` method produces. Slice is grown up to the index,
+// index beyond `MaxPathIndex` is reported as an error.
+//
+// Unflatten method accepts the `Option`(s), for eg.: `WithTagName()` to look up
+// the key names of custom tag, `WithConverters()` to assign the value with
+// converters scoped to the call.
+//
+// A "model" tag with the value of "-" is ignored by library for processing.
+//
+func Unflatten(dst interface{}, m map[string]interface{}, opts ...Option) []error {
+	dv, err := destStructValue(dst)
+	if err != nil {
+		return []error{err}
+	}
+
+	o := newOptions(opts)
+
+	// keys are processed in order to have predictable result
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var errs []error
+	for _, key := range keys {
+		segs, err := parsePath(key)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if err = setPath(dv, segs, valueOf(m[key]), o.tagName(), o.assignValue); err != nil {
+			errs = append(errs, valueError(key, valueOf(m[key]), nil, err))
+		}
+	}
+
+	return errs
+}
+
+func flattenStruct(m map[string]interface{}, prefix string, sv reflect.Value, visiting map[visitKey]bool, o *options) error {
+	ti := typeInfoOf(sv.Type())
+	tags := o.tagsOf(ti)
+	for i, f := range ti.fields {
+		fv := sv.FieldByIndex(f.Index)
+		tag := tags[i]
+
+		if tag.isOmitField() {
+			continue
+		}
+
+		key := joinPath(prefix, tag.keyName(f))
+
+		// sensitive field value is masked or omitted based on redact mode
+		if tag.isRedact() && o.redact != RedactNone {
+			if o.redact == RedactMask {
+				m[key] = RedactMaskValue
+			}
+
+			continue
+		}
+
+		// check type is in NoTraverseTypeList or has 'notraverse' tag option
+		noTraverse := (o.isNoTraverseType(fv) || tag.isNoTraverse())
+
+		if tag.isOmitZero() && isZeroValue(fv) {
+			continue
+		}
+
+		if tag.isOmitEmpty() {
+			if isStruct(fv) && !noTraverse {
+				if isStructFieldZero(fv, nil) {
+					continue
+				}
+			} else if isFieldZero(fv) {
+				continue
+			}
+		}
+
+		if noTraverse {
+			m[key] = fv.Interface()
+			continue
+		}
+
+		// embedded struct fields are flattened at embedded level
+		if f.Anonymous && isStruct(fv) && !(isPtr(fv) && fv.IsNil()) {
+			if err := flattenEmbedded(m, prefix, key, fv, visiting, o); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := flattenVal(m, key, fv, visiting, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// flattenEmbedded method flattens the embedded struct fields at embedded level,
+// embedded pointer is tracked as in traversal.
+func flattenEmbedded(m map[string]interface{}, prefix, key string, fv reflect.Value, visiting map[visitKey]bool, o *options) error {
+	if !isPtr(fv) {
+		return flattenStruct(m, prefix, fv, visiting, o)
+	}
+
+	vk := visitKeyOf(fv)
+	if visiting[vk] {
+		return newFieldError(key, nil, nil, ErrCycle, "cycle detected")
+	}
+
+	visiting[vk] = true
+	defer delete(visiting, vk)
+
+	return flattenStruct(m, prefix, fv.Elem(), visiting, o)
+}
+
+func flattenVal(m map[string]interface{}, key string, v reflect.Value, visiting map[visitKey]bool, o *options) error {
+	// take care interface{} and its actual value
+	if isInterface(v) && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	// pointer or map which is in traversal cannot be flattened
+	if (isPtr(v) || v.Kind() == reflect.Map) && !v.IsNil() {
+		vk := visitKeyOf(v)
+		if visiting[vk] {
+			return newFieldError(key, nil, nil, ErrCycle, "cycle detected")
+		}
+
+		visiting[vk] = true
+		defer delete(visiting, vk)
+	}
+
+	if isPtr(v) && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		if o.isNoTraverseType(v) {
+			break
+		}
+
+		return flattenStruct(m, key, v, visiting, o)
+	case reflect.Map:
+		if v.Len() == 0 {
+			break
+		}
+
+		for _, k := range v.MapKeys() {
+			if err := flattenVal(m, fmt.Sprintf("%v[%v]", key, k.Interface()), v.MapIndex(k), visiting, o); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Slice, reflect.Array:
+		if v.Len() == 0 || v.Type() == typeOfBytes {
+			break
+		}
+
+		for i := 0; i < v.Len(); i++ {
+			if err := flattenVal(m, fmt.Sprintf("%v[%d]", key, i), v.Index(i), visiting, o); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	m[key] = v.Interface()
+	return nil
+}
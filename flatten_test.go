@@ -0,0 +1,213 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFlatten(t *testing.T) {
+	type Level2 struct {
+		Name string
+	}
+
+	type Level1 struct {
+		Level2 Level2
+		Ptr    *Level2
+	}
+
+	type Item struct {
+		ID int
+	}
+
+	type Base struct {
+		ID int `model:"id"`
+	}
+
+	type SampleStruct struct {
+		Base
+		Level1    Level1
+		Items     []Item
+		Tags      []string
+		Meta      map[string]string
+		Empty     []string
+		Password  string    `model:"password,redact"`
+		Note      string    `model:"note,omitempty"`
+		Skipped   string    `model:"-"`
+		CreatedAt time.Time `model:"createdAt"`
+		Data      []byte
+		Any       interface{}
+	}
+
+	createdAt := time.Now()
+	src := SampleStruct{
+		Base:      Base{ID: 1},
+		Level1:    Level1{Level2: Level2{Name: "go-model"}},
+		Items:     []Item{{ID: 101}, {ID: 102}},
+		Tags:      []string{"a"},
+		Meta:      map[string]string{"region": "IN"},
+		Password:  "s3cr3t",
+		CreatedAt: createdAt,
+		Data:      []byte("data"),
+		Any:       &Item{ID: 5},
+	}
+
+	m, err := Flatten(src)
+	assertError(t, err)
+	logIt(t, "Flatten", m)
+
+	assertEqual(t, 12, len(m))
+	assertEqual(t, 1, m["id"])
+	assertEqual(t, "go-model", m["Level1.Level2.Name"])
+	assertEqual(t, true, m["Level1.Ptr"].(*Level2) == nil)
+	assertEqual(t, 101, m["Items[0].ID"])
+	assertEqual(t, 102, m["Items[1].ID"])
+	assertEqual(t, "a", m["Tags[0]"])
+	assertEqual(t, "IN", m["Meta[region]"])
+	assertEqual(t, 0, len(m["Empty"].([]string)))
+	assertEqual(t, RedactMaskValue, m["password"])
+	assertEqual(t, true, createdAt.Equal(m["createdAt"].(time.Time)))
+	assertEqual(t, []byte("data"), m["Data"])
+	assertEqual(t, 5, m["Any.ID"])
+
+	_, found := m["note"]
+	assertEqual(t, false, found)
+
+	m, err = Flatten(&src, WithRedact(RedactOmit))
+	assertError(t, err)
+	_, found = m["password"]
+	assertEqual(t, false, found)
+}
+
+func TestFlattenNotAStruct(t *testing.T) {
+	_, err := Flatten(nil)
+	assertEqual(t, "Invalid input <nil>", err.Error())
+
+	_, err = Flatten(map[string]string{})
+	assertEqual(t, "Input is not a struct", err.Error())
+}
+
+func TestFlattenCycle(t *testing.T) {
+	root := &sampleNode{Name: "root"}
+	child := &sampleNode{Name: "child", Parent: root}
+	root.Children = []*sampleNode{child}
+
+	_, err := Flatten(root)
+	assertEqual(t, "Field: 'Children[0].Parent', cycle detected", err.Error())
+
+	// shared pointer without cycle is flattened
+	shared := &sampleNode{Name: "shared"}
+	m, err := Flatten(sampleNode{Name: "a", Children: []*sampleNode{shared, shared}})
+	assertError(t, err)
+	assertEqual(t, "shared", m["Children[0].Name"])
+	assertEqual(t, "shared", m["Children[1].Name"])
+}
+
+func TestUnflatten(t *testing.T) {
+	type Level2 struct {
+		Name  string
+		Count *int
+	}
+
+	type Level1 struct {
+		Level2 *Level2
+	}
+
+	type Item struct {
+		ID int
+	}
+
+	type Base struct {
+		ID int `model:"id"`
+	}
+
+	type SampleStruct struct {
+		*Base
+		Level1    Level1
+		Items     []Item
+		ItemPtrs  []*Item
+		Meta      map[string]string
+		Counts    map[int]int `model:"counts"`
+		TTL       time.Duration
+		CreatedAt time.Time `model:"createdAt"`
+		Skipped   string    `model:"-"`
+	}
+
+	dst := SampleStruct{}
+	errs := Unflatten(&dst, map[string]interface{}{
+		"id":                  1,
+		"Level1.Level2.Name":  "go-model",
+		"Level1.Level2.Count": 10,
+		"Items[1].ID":         102,
+		"Items[0].ID":         "101",
+		"ItemPtrs[0].ID":      201,
+		"Meta[region]":        "IN",
+		"counts[2]":           4,
+		"TTL":                 "1m",
+		"createdAt":           "2018-08-27T10:00:00Z",
+	})
+	assertEqual(t, true, errs == nil)
+	logIt(t, "Unflatten", dst)
+
+	assertEqual(t, 1, dst.ID)
+	assertEqual(t, "go-model", dst.Level1.Level2.Name)
+	assertEqual(t, 10, *dst.Level1.Level2.Count)
+	assertEqual(t, 2, len(dst.Items))
+	assertEqual(t, 101, dst.Items[0].ID)
+	assertEqual(t, 102, dst.Items[1].ID)
+	assertEqual(t, 201, dst.ItemPtrs[0].ID)
+	assertEqual(t, "IN", dst.Meta["region"])
+	assertEqual(t, 4, dst.Counts[2])
+	assertEqual(t, time.Minute, dst.TTL)
+	assertEqual(t, 2018, dst.CreatedAt.Year())
+
+	// round trip
+	m, err := Flatten(dst)
+	assertError(t, err)
+
+	result := SampleStruct{}
+	errs = Unflatten(&result, m)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, true, reflect.DeepEqual(dst, result))
+}
+
+func TestUnflattenErrors(t *testing.T) {
+	type SampleStruct struct {
+		Name    string
+		Count   int
+		Items   []string
+		Skipped string `model:"-"`
+	}
+
+	dst := SampleStruct{}
+	errs := Unflatten(&dst, map[string]interface{}{
+		"Count":        "abc",
+		"Items[x]":     "a",
+		"Name.Inner":   "a",
+		"NotExists":    "a",
+		"Skipped":      "a",
+		"invalid..key": 1,
+	})
+	assertEqual(t, 6, len(errs))
+	assertEqual(t, `Field: 'Count', strconv.ParseInt: parsing "abc": invalid syntax`, errs[0].Error())
+	assertEqual(t, "Field: 'Items[x]', invalid index 'x'", errs[1].Error())
+	assertEqual(t, "Field: 'Name.Inner', cannot access 'Inner' on [string]", errs[2].Error())
+	assertEqual(t, "Field: 'NotExists', does not exists", errs[3].Error())
+	assertEqual(t, "Field: 'Skipped', does not exists", errs[4].Error())
+	assertEqual(t, "invalid path 'invalid..key'", errs[5].Error())
+
+	// slice is not grown beyond the maximum index
+	errs = Unflatten(&dst, map[string]interface{}{
+		"Items[999999999]": "a",
+	})
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, "Field: 'Items[999999999]', index '999999999' exceeds maximum index 1000", errs[0].Error())
+	assertEqual(t, 0, len(dst.Items))
+
+	errs = Unflatten(dst, nil)
+	assertEqual(t, "Destination struct is not a pointer", errs[0].Error())
+}
@@ -0,0 +1,284 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// FromMap method is the inverse of `Map()` method. It populates the exported fields
+// of destination `struct` from the given `map[string]interface{}`, nested map is
+// populated into nested struct and slice of map into slice of struct.
+// 		Example:
+//
+// 		m := map[string]interface{}{
+// 			"name":    "go-model",
+// 			"address": map[string]interface{}{"city": "Chennai"},
+// 			"items":   []interface{}{map[string]interface{}{"id": 101}},
+// 		}
+//
+// 		dst := SampleStruct{}
+// 		errs := model.FromMap(&dst, m)
+// 		if errs != nil {
+// 			fmt.Println("Errors:", errs)
+// 		}
+//
+// The key name lookup follows the same rule of `Map()` method, field name or "model"
+// tag name. Embedded struct fields are looked up at same level as represented by Go.
+// The value is assigned with the registered `Converter` if exists, pointer and
+// non-pointer value is adapted and the string value is parsed into the field type
+// (see `FromStringMap()` method). Map keys without a matching field are ignored.
+//
+// A "model" tag with the value of "-" is ignored by library for processing.
+//
+// A "model" tag value with the option of "notraverse"; library will not traverse
+// inside the struct object, value is assigned as-is.
+//
+// FromMap method accepts the `Option`(s), for eg.: `WithWeakTyping()` to coerce the
+// loosely-typed values and `WithTextMarshaler()` to parse the string value with
+// `encoding.TextUnmarshaler` of the field type.
+//
+func FromMap(dst interface{}, m map[string]interface{}, opts ...Option) []error {
+	dv, err := destStructValue(dst)
+	if err != nil {
+		return []error{err}
+	}
+
+	errs, _ := fromMap(dv, m, "", newOptions(opts))
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// Construct method allocates a new instance of given `struct` type and populates
+// it from the values via `FromMap()` method; it's single entry point for hydration
+// by type. The result is a pointer of the struct type.
+// 		Example:
+//
+// 		v, errs := model.Construct(reflect.TypeOf(Product{}), values)
+// 		if errs != nil {
+// 			fmt.Println("Errors:", errs)
+// 		}
+//
+// 		product := v.(*Product)
+//
+func Construct(t reflect.Type, values map[string]interface{}, opts ...Option) (interface{}, []error) {
+	if t == nil {
+		return nil, []error{newError(ErrNilInput, "Invalid input <nil>")}
+	}
+
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct {
+		return nil, []error{newError(ErrNotStruct, "Input is not a struct")}
+	}
+
+	dv := reflect.New(t)
+	errs, _ := fromMap(dv.Elem(), values, "", newOptions(opts))
+	if len(errs) > 0 {
+		return dv.Interface(), errs
+	}
+
+	return dv.Interface(), nil
+}
+
+// fromMap method populates the struct from map, it reports whether any of the
+// field is found in the map.
+func fromMap(dv reflect.Value, m map[string]interface{}, prefix string, o *options) ([]error, bool) {
+	var (
+		errs  []error
+		found bool
+	)
+
+	for _, f := range modelFields(dv) {
+		fv := dv.FieldByIndex(f.Index)
+		tag := o.mapTagOf(f)
+
+		if tag.isOmitField() || !fv.CanSet() {
+			continue
+		}
+
+		noTraverse := (o.isNoTraverseType(fv) || tag.isNoTraverse())
+
+		// embedded struct and 'inline' option fields are looked up at embedded level
+		if (f.Anonymous || tag.isInline()) && !noTraverse && indirectType(f.Type).Kind() == reflect.Struct {
+			ev := reflect.New(indirectType(f.Type)).Elem()
+			if isPtr(fv) && !fv.IsNil() {
+				ev = fv.Elem()
+			} else if !isPtr(fv) {
+				ev = fv
+			}
+
+			innerErrs, innerFound := fromMap(ev, m, prefix, o)
+			errs = append(errs, innerErrs...)
+			if innerFound && isPtr(fv) && fv.IsNil() {
+				fv.Set(ev.Addr())
+			}
+			found = found || innerFound
+			continue
+		}
+
+		val, exists := m[tag.keyName(f)]
+		if !exists {
+			continue
+		}
+		found = true
+
+		path := joinPath(prefix, f.Name)
+
+		// time layout of "timefmt" option
+		if layout, found := tag.timeLayout(); found {
+			if handled, timeErrs := fromTimeFormatted(fv, val, layout, path, o); handled {
+				errs = append(errs, timeErrs...)
+				continue
+			}
+		}
+
+		errs = append(errs, fromMapVal(fv, val, path, noTraverse, o)...)
+	}
+
+	return errs, found
+}
+
+func fromMapVal(fv reflect.Value, val interface{}, path string, notraverse bool, o *options) []error {
+	ft := fv.Type()
+	vv := valueOf(val)
+
+	if val == nil {
+		fv.Set(reflect.Zero(ft))
+		return nil
+	}
+
+	if o.conversionExists(vv.Type(), ft) || vv.Type().AssignableTo(ft) {
+		return assignField(fv, vv, path, o)
+	}
+
+	// duration is converted from and into string
+	if isDurationConvertible(vv.Type(), ft) {
+		v, err := convertDuration(vv, ft)
+		if err != nil {
+			return []error{valueError(path, vv, ft, err)}
+		}
+
+		fv.Set(v)
+		return nil
+	}
+
+	// UUID is converted from and into string
+	if isUUIDConvertible(vv.Type(), ft) {
+		v, err := convertUUID(vv, ft)
+		if err != nil {
+			return []error{valueError(path, vv, ft, err)}
+		}
+
+		fv.Set(v)
+		return nil
+	}
+
+	// text is parsed by the field type itself
+	if o.textMarshal && vv.Kind() == reflect.String {
+		if handled, errs := unmarshalText(fv, vv.String(), path); handled {
+			return errs
+		}
+	}
+
+	switch ft.Kind() {
+	case reflect.Ptr:
+		ev := reflect.New(ft.Elem())
+		if errs := fromMapVal(ev.Elem(), val, path, notraverse, o); len(errs) > 0 {
+			return errs
+		}
+
+		fv.Set(ev)
+		return nil
+	case reflect.Struct:
+		if m, ok := val.(map[string]interface{}); ok && !notraverse {
+			errs, _ := fromMap(fv, m, path, o)
+			return errs
+		}
+	case reflect.Slice:
+		// single value is the only element
+		if o.singleAsSlice && vv.Kind() != reflect.Slice && vv.Kind() != reflect.Array && ft != typeOfBytes {
+			vv = valueOf([]interface{}{val})
+		}
+
+		if vv.Kind() != reflect.Slice && vv.Kind() != reflect.Array {
+			break
+		}
+
+		var errs []error
+		sv := reflect.MakeSlice(ft, vv.Len(), vv.Len())
+		for i := 0; i < vv.Len(); i++ {
+			errs = append(errs, fromMapVal(sv.Index(i), vv.Index(i).Interface(),
+				fmt.Sprintf("%v[%d]", path, i), o.isNoTraverseType(sv.Index(i)), o)...)
+		}
+
+		fv.Set(sv)
+		return errs
+	case reflect.Map:
+		if vv.Kind() != reflect.Map {
+			break
+		}
+
+		var errs []error
+		mv := reflect.MakeMapWithSize(ft, vv.Len())
+		for _, k := range vv.MapKeys() {
+			kpath := fmt.Sprintf("%v[%v]", path, k.Interface())
+
+			kv := reflect.New(ft.Key()).Elem()
+			if err := o.assignValue(kv, k); err != nil {
+				errs = append(errs, newFieldError(kpath, k.Type(), ft.Key(), withSentinel(ErrTypeMismatch, err),
+					"invalid key, %v", err))
+				continue
+			}
+
+			ev := reflect.New(ft.Elem()).Elem()
+			innerErrs := fromMapVal(ev, vv.MapIndex(k).Interface(), kpath, o.isNoTraverseType(ev), o)
+			if len(innerErrs) > 0 {
+				errs = append(errs, innerErrs...)
+				continue
+			}
+
+			mv.SetMapIndex(kv, ev)
+		}
+
+		fv.Set(mv)
+		return errs
+	}
+
+	// loosely-typed value is coerced in weak typing mode
+	if o.weakTyping && isWeakKind(vv.Kind()) && isWeakKind(ft.Kind()) {
+		v, err := weakConvert(vv, ft)
+		if err != nil {
+			return []error{valueError(path, vv, ft, err)}
+		}
+
+		fv.Set(v)
+		return nil
+	}
+
+	return assignField(fv, vv, path, o)
+}
+
+func assignField(fv, val reflect.Value, path string, o *options) []error {
+	if err := o.assignValue(fv, val); err != nil {
+		return []error{valueError(path, val, fv.Type(), err)}
+	}
+
+	return nil
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Ptr {
+		return t.Elem()
+	}
+
+	return t
+}
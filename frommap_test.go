@@ -0,0 +1,166 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+type FromMapAudit struct {
+	CreatedBy string    `model:"createdBy"`
+	CreatedAt time.Time `model:"createdAt"`
+}
+
+type fromMapItem struct {
+	ID   int    `model:"id"`
+	Name string `model:"name"`
+}
+
+type fromMapSample struct {
+	*FromMapAudit
+	Name     string                 `model:"name"`
+	Count    *int                   `model:"count"`
+	Secret   string                 `model:"-"`
+	Address  fromMapItem            `model:"address"`
+	Billing  *fromMapItem           `model:"billing"`
+	Items    []fromMapItem          `model:"items"`
+	Labels   map[string]fromMapItem `model:"labels"`
+	Codes    map[int]string         `model:"codes"`
+	Tags     []string               `model:"tags"`
+	Duration time.Duration          `model:"duration"`
+}
+
+func TestFromMap(t *testing.T) {
+	createdAt := time.Date(2016, 8, 1, 10, 30, 0, 0, time.UTC)
+	m := map[string]interface{}{
+		"createdBy": "jeeva",
+		"createdAt": createdAt,
+		"name":      "go-model",
+		"count":     10,
+		"Secret":    "s3cr3t",
+		"address":   map[string]interface{}{"id": 1, "name": "home"},
+		"billing":   map[string]interface{}{"id": "2"},
+		"items":     []interface{}{map[string]interface{}{"id": 3}, nil},
+		"labels":    map[string]interface{}{"office": map[string]interface{}{"name": "office"}},
+		"codes":     map[string]interface{}{"91": "IN"},
+		"tags":      []interface{}{"a", "b"},
+		"duration":  "30s",
+		"unknown":   true,
+	}
+
+	dst := fromMapSample{}
+	errs := FromMap(&dst, m)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "jeeva", dst.CreatedBy)
+	assertEqual(t, true, createdAt.Equal(dst.CreatedAt))
+	assertEqual(t, "go-model", dst.Name)
+	assertEqual(t, 10, *dst.Count)
+	assertEqual(t, "", dst.Secret)
+	assertEqual(t, "home", dst.Address.Name)
+	assertEqual(t, 2, dst.Billing.ID)
+	assertEqual(t, 2, len(dst.Items))
+	assertEqual(t, 3, dst.Items[0].ID)
+	assertEqual(t, "office", dst.Labels["office"].Name)
+	assertEqual(t, "IN", dst.Codes[91])
+	assertEqual(t, []string{"a", "b"}, dst.Tags)
+	assertEqual(t, 30*time.Second, dst.Duration)
+
+	// embedded pointer is allocated only if it's fields exist
+	dst = fromMapSample{}
+	errs = FromMap(&dst, map[string]interface{}{"name": "go-model"})
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, true, dst.FromMapAudit == nil)
+}
+
+func TestFromMapRoundTrip(t *testing.T) {
+	count := 10
+	src := fromMapSample{
+		FromMapAudit: &FromMapAudit{CreatedBy: "jeeva"},
+		Name:         "go-model",
+		Count:        &count,
+		Address:      fromMapItem{ID: 1, Name: "home"},
+		Billing:      &fromMapItem{ID: 2},
+		Items:        []fromMapItem{{ID: 3}},
+		Tags:         []string{"a"},
+	}
+
+	m, err := Map(src)
+	assertError(t, err)
+
+	dst := fromMapSample{}
+	errs := FromMap(&dst, m)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, true, reflect.DeepEqual(src, dst))
+}
+
+func TestFromMapErrors(t *testing.T) {
+	m := map[string]interface{}{
+		"count":   "ten",
+		"address": map[string]interface{}{"id": []int{1}},
+		"codes":   map[string]interface{}{"IN": "India"},
+	}
+
+	errs := FromMap(&fromMapSample{}, m)
+	assertEqual(t, 3, len(errs))
+
+	errs = FromMap(fromMapSample{}, m)
+	assertEqual(t, "Destination struct is not a pointer", errs[0].Error())
+}
+
+func TestConstruct(t *testing.T) {
+	v, errs := Construct(reflect.TypeOf(fromMapSample{}), map[string]interface{}{
+		"name":  "go-model",
+		"items": []interface{}{map[string]interface{}{"id": 1}},
+	})
+	assertEqual(t, true, errs == nil)
+
+	dst := v.(*fromMapSample)
+	assertEqual(t, "go-model", dst.Name)
+	assertEqual(t, 1, dst.Items[0].ID)
+
+	v, errs = Construct(reflect.TypeOf(&fromMapItem{}), map[string]interface{}{"id": "one"})
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, true, v.(*fromMapItem) != nil)
+
+	_, errs = Construct(reflect.TypeOf(""), nil)
+	assertEqual(t, "Input is not a struct", errs[0].Error())
+
+	_, errs = Construct(nil, nil)
+	assertEqual(t, "Invalid input <nil>", errs[0].Error())
+}
+
+func TestFromMapJSONTags(t *testing.T) {
+	type Product struct {
+		Name   string `json:"name"`
+		Secret string `json:"-"`
+		SKU    string `json:"sku" model:"productSku"`
+		Price  int    `json:",omitempty"`
+	}
+
+	m := map[string]interface{}{
+		"name":       "go-model",
+		"Secret":     "s3cr3t",
+		"productSku": "GM-01",
+		"sku":        "ignored",
+		"Price":      10,
+	}
+
+	var p Product
+	errs := FromMap(&p, m, WithJSONTags())
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "go-model", p.Name)
+	assertEqual(t, "", p.Secret)
+	assertEqual(t, "GM-01", p.SKU)
+	assertEqual(t, 10, p.Price)
+
+	// json tags are not used by default
+	p = Product{}
+	errs = FromMap(&p, m)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "", p.Name)
+	assertEqual(t, "s3cr3t", p.Secret)
+}
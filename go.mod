@@ -1 +1,3 @@
 module gopkg.in/jeevatkm/go-model.v1
+
+go 1.20
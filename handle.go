@@ -0,0 +1,184 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// HandlePolicy is used to decide how the value holding OS, process or runtime
+// handle (timer, context, file descriptor, etc.) is processed by `Copy()` and
+// `Clone()` methods. Such values are never copied naively.
+type HandlePolicy uint8
+
+// Handle policies
+const (
+	// HandleSkip leaves the destination untouched and reports `HandleWarning`,
+	// it's default policy
+	HandleSkip HandlePolicy = iota
+
+	// HandleShare assigns the same handle into destination
+	HandleShare
+)
+
+// HandleWarning is reported by `Copy()` and `Clone()` methods in the errors
+// when the value holding handle is skipped as per `HandleSkip` policy.
+type HandleWarning struct {
+	Type reflect.Type
+}
+
+// Error method is implementation of error interface.
+func (w *HandleWarning) Error() string {
+	return fmt.Sprintf("Type [%v] holds handle, it's not copied", w.Type)
+}
+
+var (
+	// handleTypeList keeps track of handle types and it's policy at library level
+	handleTypeList = map[reflect.Type]HandlePolicy{}
+
+	// handlePolicyCache keeps the resolved policy of the type, since checking
+	// the type implements registered interface on every field is costly.
+	// It's cleared on registration.
+	handlePolicyCache sync.Map // map[reflect.Type]handlePolicyEntry
+)
+
+type handlePolicyEntry struct {
+	policy HandlePolicy
+	found  bool
+}
+
+// AddHandleType method registers the Go Lang type(s) holding handle with given
+// `HandlePolicy`. Interface type is registered by supplying pointer of the
+// interface, for eg.: `(*net.Conn)(nil)`, then the types implementing it are
+// handled too. See also `RemoveHandleType()` method.
+// 		model.AddHandleType(model.HandleShare, &sql.DB{}, (*net.Conn)(nil))
+//
+// Default handle types: time.Timer{}, &time.Timer{}, time.Ticker{}, &time.Ticker{},
+// os.File{}, &os.File{}, os.Process{}, &os.Process{}, context.Context
+//
+func AddHandleType(policy HandlePolicy, i ...interface{}) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	for _, v := range i {
+		handleTypeList[handleTypeOf(v)] = policy
+	}
+	clearHandlePolicyCache()
+}
+
+// RemoveHandleType method is used to remove Go Lang type(s) from the handle type
+// list. See also `AddHandleType()` method.
+func RemoveHandleType(i ...interface{}) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	for _, v := range i {
+		delete(handleTypeList, handleTypeOf(v))
+	}
+	clearHandlePolicyCache()
+}
+
+func clearHandlePolicyCache() {
+	handlePolicyCache.Range(func(k, _ interface{}) bool {
+		handlePolicyCache.Delete(k)
+		return true
+	})
+}
+
+func handleTypeOf(v interface{}) reflect.Type {
+	t := reflect.TypeOf(v)
+	if t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Interface {
+		return t.Elem()
+	}
+
+	return t
+}
+
+// handlePolicyOf method returns the policy if given type holds handle.
+func handlePolicyOf(t reflect.Type) (HandlePolicy, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	if e, found := handlePolicyCache.Load(t); found {
+		return e.(handlePolicyEntry).policy, e.(handlePolicyEntry).found
+	}
+
+	e := resolveHandlePolicy(t)
+	handlePolicyCache.Store(t, e)
+	return e.policy, e.found
+}
+
+// resolveHandlePolicy method looks up the policy of type in the handle type
+// list, then the registered interfaces which the type implements.
+func resolveHandlePolicy(t reflect.Type) handlePolicyEntry {
+	if policy, found := handleTypeList[t]; found {
+		return handlePolicyEntry{policy: policy, found: true}
+	}
+
+	if t.Kind() == reflect.Interface {
+		return handlePolicyEntry{}
+	}
+
+	for ht, policy := range handleTypeList {
+		if ht.Kind() == reflect.Interface && t.Implements(ht) {
+			return handlePolicyEntry{policy: policy, found: true}
+		}
+	}
+
+	return handlePolicyEntry{}
+}
+
+// isHandle method returns true if the given value holds handle.
+func isHandle(v reflect.Value) bool {
+	if _, found := handlePolicyOf(v.Type()); found {
+		return true
+	}
+
+	if isInterface(v) && !v.IsNil() {
+		_, found := handlePolicyOf(v.Elem().Type())
+		return found
+	}
+
+	return false
+}
+
+// copyHandle method processes the value holding handle as per it's policy.
+// It returns false if the given value is not a handle.
+func copyHandle(f reflect.Value) (reflect.Value, bool, error) {
+	policy, found := handlePolicyOf(f.Type())
+	if !found {
+		return reflect.Value{}, false, nil
+	}
+
+	if policy == HandleShare {
+		return f, true, nil
+	}
+
+	// nothing to skip
+	if isFieldZero(f) {
+		return reflect.Zero(f.Type()), true, nil
+	}
+
+	return reflect.Value{}, true, &HandleWarning{Type: f.Type()}
+}
+
+func init() {
+	AddHandleType(HandleSkip,
+		time.Timer{},
+		&time.Timer{},
+		time.Ticker{},
+		&time.Ticker{},
+		os.File{},
+		&os.File{},
+		os.Process{},
+		&os.Process{},
+		(*context.Context)(nil),
+	)
+}
@@ -0,0 +1,123 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCopyHandleTypes(t *testing.T) {
+	type Worker struct {
+		Name    string
+		Timer   *time.Timer
+		Ctx     context.Context
+		File    *os.File
+		Files   []*os.File
+		Any     interface{}
+		Stopped *time.Timer
+	}
+
+	timer := time.NewTimer(time.Hour)
+	defer timer.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	src := Worker{
+		Name:  "worker",
+		Timer: timer,
+		Ctx:   ctx,
+		File:  os.Stdout,
+		Files: []*os.File{os.Stderr},
+		Any:   os.Stdin,
+	}
+
+	// default policy skips with warning
+	dst := Worker{}
+	errs := Copy(&dst, src)
+	assertEqual(t, 5, len(errs))
+	for _, err := range errs {
+		_, ok := err.(*HandleWarning)
+		assertEqual(t, true, ok)
+	}
+	assertEqual(t, "Type [*time.Timer] holds handle, it's not copied", errs[0].Error())
+	assertEqual(t, "worker", dst.Name)
+	assertEqual(t, true, dst.Timer == nil)
+	assertEqual(t, true, dst.Ctx == nil)
+	assertEqual(t, true, dst.File == nil)
+	assertEqual(t, 1, len(dst.Files))
+	assertEqual(t, true, dst.Files[0] == nil)
+	assertEqual(t, true, dst.Any == nil)
+
+	// share policy
+	AddHandleType(HandleShare, &os.File{}, (*context.Context)(nil))
+	defer AddHandleType(HandleSkip, &os.File{}, (*context.Context)(nil))
+
+	dst = Worker{}
+	errs = Copy(&dst, src)
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, true, dst.Ctx == ctx)
+	assertEqual(t, true, dst.File == os.Stdout)
+	assertEqual(t, true, dst.Files[0] == os.Stderr)
+	assertEqual(t, true, dst.Any.(*os.File) == os.Stdin)
+
+	// removed from handle list, then copied naively
+	RemoveHandleType(&time.Timer{})
+	defer AddHandleType(HandleSkip, &time.Timer{})
+
+	dst = Worker{}
+	errs = Copy(&dst, src)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, true, dst.Timer != nil)
+}
+
+func TestCopyNilInterfaceField(t *testing.T) {
+	type Worker struct {
+		Name string
+		Ctx  context.Context
+		Any  interface{}
+	}
+
+	dst := Worker{Ctx: context.Background(), Any: "existing"}
+	errs := Copy(&dst, Worker{Name: "go-model"})
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "go-model", dst.Name)
+	assertEqual(t, true, dst.Ctx == nil)
+	assertEqual(t, true, dst.Any == nil)
+}
+
+type sampleConn struct {
+	Addr string
+}
+
+func (c *sampleConn) Close() error {
+	return nil
+}
+
+func TestHandlePolicyOfInterface(t *testing.T) {
+	ct := reflect.TypeOf(&sampleConn{})
+	_, found := handlePolicyOf(ct)
+	assertEqual(t, false, found)
+
+	// resolved policy is refreshed on registration
+	AddHandleType(HandleShare, (*io.Closer)(nil))
+	policy, found := handlePolicyOf(ct)
+	assertEqual(t, true, found)
+	assertEqual(t, HandleShare, policy)
+
+	// interface type itself is handle only if it's registered
+	_, found = handlePolicyOf(reflect.TypeOf((*fmt.Stringer)(nil)).Elem())
+	assertEqual(t, false, found)
+
+	RemoveHandleType((*io.Closer)(nil))
+	_, found = handlePolicyOf(ct)
+	assertEqual(t, false, found)
+}
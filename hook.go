@@ -0,0 +1,57 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import "reflect"
+
+// FieldHook is used to intercept the field copy, see `WithFieldHook()` option.
+// The path is the field path from the root struct (see `Get()` method), src is
+// the source field value and dst is the destination field, it's invalid if the
+// field does not exists in destination.
+//
+// Hook returns the value to be copied, either src itself or transformed one.
+// Invalid value is treated as src. Returning false vetoes the field copy and
+// the destination stays untouched, error is reported as field error.
+type FieldHook func(path string, src, dst reflect.Value) (reflect.Value, bool, error)
+
+// WithFieldHook option calls the given `FieldHook` before each field copy, so the
+// individual field copies can be vetoed, transformed or logged without writing
+// type converters. Hook is called for the nested struct field itself and then
+// for its fields.
+// 		Example:
+//
+// 		errs := model.Copy(&dst, src, model.WithFieldHook(
+// 			func(path string, src, dst reflect.Value) (reflect.Value, bool, error) {
+// 				if path == "Password" {
+// 					return src, false, nil
+// 				}
+//
+// 				log.Printf("copying %v", path)
+// 				return src, true, nil
+// 			}))
+//
+func WithFieldHook(hook FieldHook) Option {
+	return func(o *options) {
+		o.fieldHook = hook
+	}
+}
+
+// hookField method applies the field hook of the copy, if it's configured.
+func (cs *copyState) hookField(path string, sfv, dfv reflect.Value) (reflect.Value, bool, error) {
+	if cs.opts.fieldHook == nil {
+		return sfv, true, nil
+	}
+
+	v, ok, err := cs.opts.fieldHook(path, sfv, dfv)
+	if err != nil || !ok {
+		return sfv, false, err
+	}
+
+	if !v.IsValid() {
+		return sfv, true, nil
+	}
+
+	return v, true, nil
+}
@@ -0,0 +1,69 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCopyFieldHook(t *testing.T) {
+	type Address struct {
+		City string
+	}
+
+	type User struct {
+		Name     string
+		Password string
+		Email    string
+		Address  Address
+		Tags     []Address
+	}
+
+	src := User{
+		Name:     "jeeva",
+		Password: "secret",
+		Email:    "Jeeva@MyJeeva.com",
+		Address:  Address{City: "Chennai"},
+		Tags:     []Address{{City: "Madurai"}},
+	}
+
+	var paths []string
+	hook := func(path string, src, dst reflect.Value) (reflect.Value, bool, error) {
+		paths = append(paths, path)
+		switch path {
+		case "Password":
+			return src, false, nil
+		case "Email":
+			return reflect.ValueOf(strings.ToLower(src.String())), true, nil
+		}
+
+		return src, true, nil
+	}
+
+	dst := User{Password: "existing"}
+	errs := Copy(&dst, src, WithFieldHook(hook))
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, "jeeva", dst.Name)
+	assertEqual(t, "existing", dst.Password)
+	assertEqual(t, "jeeva@myjeeva.com", dst.Email)
+	assertEqual(t, "Chennai", dst.Address.City)
+	assertEqual(t, "Madurai", dst.Tags[0].City)
+	assertEqual(t, []string{"Name", "Password", "Email", "Address", "Address.City", "Tags", "Tags[0].City"}, paths)
+
+	// hook error is reported as field error
+	errInvalid := errors.New("city is not allowed")
+	errs = Copy(&dst, src, WithFieldHook(func(path string, src, dst reflect.Value) (reflect.Value, bool, error) {
+		if path == "Address.City" {
+			return reflect.Value{}, false, errInvalid
+		}
+		return reflect.Value{}, true, nil
+	}))
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, "Field: 'Address.City', city is not allowed", errs[0].Error())
+	assertEqual(t, true, errors.Is(errs[0], errInvalid))
+}
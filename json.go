@@ -0,0 +1,58 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// ToJSON method serializes the given `struct` into JSON through `Map()` method,
+// so the "model" tag controls the key names, omitempty, redaction and converters
+// instead of "json" tag. It accepts all the options of `Map()` method.
+// 		Example:
+//
+// 		src := SampleStruct { /* source struct field values go here */ }
+//
+// 		data, err := model.ToJSON(src, model.WithRedact(model.RedactMask))
+// 		if err != nil {
+// 			fmt.Println("Error:", err)
+// 		}
+//
+func ToJSON(s interface{}, opts ...Option) ([]byte, error) {
+	m, err := Map(s, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(m)
+}
+
+// FromJSON method is the inverse of `ToJSON()` method. It populates the destination
+// `struct` from the given JSON object through `FromMap()` method, so the key names
+// are looked up same as "model" tag. JSON number is parsed into the field type.
+// It accepts all the options of `FromMap()` method.
+// 		Example:
+//
+// 		dst := SampleStruct{}
+// 		errs := model.FromJSON(&dst, data)
+// 		if errs != nil {
+// 			fmt.Println("Errors:", errs)
+// 		}
+//
+func FromJSON(dst interface{}, data []byte, opts ...Option) []error {
+	if _, err := destStructValue(dst); err != nil {
+		return []error{err}
+	}
+
+	var m map[string]interface{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	if err := d.Decode(&m); err != nil {
+		return []error{err}
+	}
+
+	return FromMap(dst, m, opts...)
+}
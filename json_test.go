@@ -0,0 +1,104 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestToJSON(t *testing.T) {
+	type Address struct {
+		City string `model:"city" json:"City"`
+		Zip  string `model:"zip,omitempty"`
+	}
+
+	type User struct {
+		Name      string    `model:"name" json:"full_name"`
+		Password  string    `model:"password,redact"`
+		Nickname  string    `model:"nickname,omitempty"`
+		Age       int       `model:"age"`
+		Address   *Address  `model:"address"`
+		Addresses []Address `model:"addresses"`
+		Internal  string    `model:"-"`
+	}
+
+	src := User{
+		Name:      "jeeva",
+		Password:  "s3cr3t",
+		Age:       30,
+		Address:   &Address{City: "Chennai"},
+		Addresses: []Address{{City: "Madurai", Zip: "625001"}},
+		Internal:  "internal",
+	}
+
+	data, err := ToJSON(src, WithRedact(RedactMask))
+	assertError(t, err)
+	assertEqual(t, `{"address":{"city":"Chennai"},"addresses":[{"city":"Madurai","zip":"625001"}],`+
+		`"age":30,"name":"jeeva","password":"***"}`, string(data))
+
+	var dst User
+	errs := FromJSON(&dst, data)
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, "jeeva", dst.Name)
+	assertEqual(t, "***", dst.Password)
+	assertEqual(t, 30, dst.Age)
+	assertEqual(t, "Chennai", dst.Address.City)
+	assertEqual(t, "625001", dst.Addresses[0].Zip)
+	assertEqual(t, "", dst.Internal)
+
+	_, err = ToJSON(nil)
+	assertEqual(t, true, errors.Is(err, ErrNilInput))
+}
+
+func TestJSONDurationRoundTrip(t *testing.T) {
+	type Job struct {
+		Name     string        `model:"name"`
+		Timeout  time.Duration `model:"timeout"`
+		Interval *time.Duration
+		Retries  []time.Duration
+	}
+
+	interval := 5 * time.Second
+	src := Job{Name: "sync", Timeout: time.Minute, Interval: &interval, Retries: []time.Duration{time.Second, 2 * time.Second}}
+
+	data, err := ToJSON(src)
+	assertError(t, err)
+	assertEqual(t, `{"Interval":5000000000,"Retries":[1000000000,2000000000],"name":"sync","timeout":60000000000}`, string(data))
+
+	var dst Job
+	errs := FromJSON(&dst, data)
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, src.Timeout, dst.Timeout)
+	assertEqual(t, interval, *dst.Interval)
+	assertEqual(t, src.Retries, dst.Retries)
+
+	// duration string is accepted too
+	errs = FromJSON(&dst, []byte(`{"timeout":"30s"}`))
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, 30*time.Second, dst.Timeout)
+
+	errs = FromJSON(&dst, []byte(`{"timeout":1.5}`))
+	assertEqual(t, 1, len(errs))
+}
+
+func TestFromJSONErrors(t *testing.T) {
+	type User struct {
+		Age int `model:"age"`
+	}
+
+	var dst User
+	errs := FromJSON(dst, []byte(`{}`))
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, true, errors.Is(errs[0], ErrNotPointer))
+
+	errs = FromJSON(&dst, []byte(`[1, 2]`))
+	assertEqual(t, 1, len(errs))
+
+	errs = FromJSON(&dst, []byte(`{"age": "thirty"}`))
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, true, dst.Age == 0)
+}
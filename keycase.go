@@ -0,0 +1,93 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+// KeyCase is used to transform the field name into the key name of `Map()` result.
+type KeyCase func(name string) string
+
+// WithKeyCase option transforms the field name keys of `Map()` result with given
+// `KeyCase`, so the result matches JSON or DB conventions without tagging every
+// field. Key name given in the tag is used as-is.
+// 		Example:
+//
+// 		m, err := model.Map(src, model.WithSnakeCaseKeys())
+//
+// 		m, err = model.Map(src, model.WithKeyCase(strings.ToLower))
+//
+func WithKeyCase(kc KeyCase) Option {
+	return func(o *options) {
+		o.keyCase = kc
+	}
+}
+
+// WithSnakeCaseKeys option transforms the field name into snake case key of
+// `Map()` result, for eg.: "ProductID" into "product_id".
+func WithSnakeCaseKeys() Option {
+	return WithKeyCase(snakeCase)
+}
+
+// WithCamelCaseKeys option transforms the field name into camel case key of
+// `Map()` result, for eg.: "ProductID" into "productID".
+func WithCamelCaseKeys() Option {
+	return WithKeyCase(camelCase)
+}
+
+// keyNameOf method returns the map key name of the field as per tag and key case
+// of the call.
+func (o *options) keyNameOf(t *tag, f reflect.StructField) string {
+	if isStringEmpty(t.Name) && o != nil && o.keyCase != nil {
+		return o.keyCase(f.Name)
+	}
+
+	return t.keyName(f)
+}
+
+// snakeCase method returns the snake case of given name, acronym is treated as
+// a word, for eg.: "HTTPServer" into "http_server".
+func snakeCase(name string) string {
+	rs := []rune(name)
+
+	var sb strings.Builder
+	for i, r := range rs {
+		if unicode.IsUpper(r) && i > 0 {
+			prev := rs[i-1]
+			nextLower := i+1 < len(rs) && unicode.IsLower(rs[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				sb.WriteByte('_')
+			}
+		}
+		sb.WriteRune(unicode.ToLower(r))
+	}
+
+	return sb.String()
+}
+
+// camelCase method returns the camel case of given name, leading acronym is
+// lower cased, for eg.: "URLPath" into "urlPath".
+func camelCase(name string) string {
+	rs := []rune(name)
+
+	n := 0
+	for n < len(rs) && unicode.IsUpper(rs[n]) {
+		n++
+	}
+
+	// last upper case letter of acronym starts the next word
+	if n > 1 && n < len(rs) && unicode.IsLower(rs[n]) {
+		n--
+	}
+
+	for i := 0; i < n; i++ {
+		rs[i] = unicode.ToLower(rs[i])
+	}
+
+	return string(rs)
+}
@@ -0,0 +1,61 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMapKeyCase(t *testing.T) {
+	type Vendor struct {
+		VendorName string
+	}
+
+	type Product struct {
+		ProductID  int
+		URLPath    string
+		HTTPServer string
+		Name       string `model:"Title"`
+		Vendor     Vendor
+	}
+
+	p := Product{ProductID: 1, URLPath: "/go-model", HTTPServer: "nginx", Name: "go-model", Vendor: Vendor{VendorName: "jeeva"}}
+
+	m, err := Map(p, WithSnakeCaseKeys())
+	assertError(t, err)
+	assertEqual(t, 1, m["product_id"])
+	assertEqual(t, "/go-model", m["url_path"])
+	assertEqual(t, "nginx", m["http_server"])
+	assertEqual(t, "go-model", m["Title"])
+	assertEqual(t, map[string]interface{}{"vendor_name": "jeeva"}, m["vendor"])
+
+	m, err = Map(p, WithCamelCaseKeys())
+	assertError(t, err)
+	assertEqual(t, 1, m["productID"])
+	assertEqual(t, "/go-model", m["urlPath"])
+	assertEqual(t, "nginx", m["httpServer"])
+	assertEqual(t, "go-model", m["Title"])
+	assertEqual(t, map[string]interface{}{"vendorName": "jeeva"}, m["vendor"])
+
+	m, err = Map(p, WithKeyCase(strings.ToUpper))
+	assertError(t, err)
+	assertEqual(t, 1, m["PRODUCTID"])
+	assertEqual(t, "go-model", m["Title"])
+}
+
+func TestKeyCaseConversion(t *testing.T) {
+	for name, expected := range map[string][2]string{
+		"Name":        {"name", "name"},
+		"ID":          {"id", "id"},
+		"ProductID":   {"product_id", "productID"},
+		"URLPath":     {"url_path", "urlPath"},
+		"HTTPServer2": {"http_server2", "httpServer2"},
+		"Address2Zip": {"address2_zip", "address2Zip"},
+	} {
+		assertEqual(t, expected[0], snakeCase(name))
+		assertEqual(t, expected[1], camelCase(name))
+	}
+}
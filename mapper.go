@@ -0,0 +1,87 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+// Mapper is the bidirectional mapper of `struct` types A and B built on one shared
+// field and converter configuration, so round-trip entity and DTO mapping doesn't
+// require two mirrored `Profile`. Create it using `NewMapper()` method and configure
+// it before use; configured mapper is safe for concurrent use.
+type Mapper[A, B any] struct {
+	forward *Profile
+	reverse *Profile
+}
+
+// NewMapper method creates the bidirectional mapper of `struct` types A and B.
+// 		Example:
+//
+// 		m := model.NewMapper[User, UserDto]().
+// 			Field("FullName", "Name").
+// 			Ignore("Password").
+// 			Convert((*time.Time)(nil), (*int64)(nil), unixConverter).
+// 			Convert((*int64)(nil), (*time.Time)(nil), timeConverter)
+//
+// 		dto, errs := m.Map(user)
+// 		user, errs = m.Reverse(dto)
+//
+func NewMapper[A, B any]() *Mapper[A, B] {
+	var (
+		a A
+		b B
+	)
+
+	return &Mapper[A, B]{
+		forward: NewProfile(&a, &b),
+		reverse: NewProfile(&b, &a),
+	}
+}
+
+// Field method maps the field path of A with field path of B in both directions,
+// see `ProfileField.From()` method.
+func (m *Mapper[A, B]) Field(aPath, bPath string) *Mapper[A, B] {
+	m.forward.Field(bPath).From(aPath)
+	m.reverse.Field(aPath).From(bPath)
+	return m
+}
+
+// Ignore method excludes the field name(s) from mapping in both directions, the
+// field name is expected to exist in both A and B.
+func (m *Mapper[A, B]) Ignore(paths ...string) *Mapper[A, B] {
+	m.forward.Ignore(paths...)
+	m.reverse.Ignore(paths...)
+	return m
+}
+
+// Convert method registers the custom `Converter` for the datatype pair by
+// supplying pointers of the target types. The converter is applied in the
+// direction in which datatype pair occurs.
+func (m *Mapper[A, B]) Convert(in interface{}, out interface{}, converter Converter) *Mapper[A, B] {
+	m.forward.Convert(in, out, converter)
+	m.reverse.Convert(in, out, converter)
+	return m
+}
+
+// Map method maps the value of A into new value of B.
+func (m *Mapper[A, B]) Map(a A, opts ...Option) (B, []error) {
+	var b B
+	errs := mapperCopy(m.forward, &b, a, opts)
+	return b, errs
+}
+
+// Reverse method maps the value of B into new value of A.
+func (m *Mapper[A, B]) Reverse(b B, opts ...Option) (A, []error) {
+	var a A
+	errs := mapperCopy(m.reverse, &a, b, opts)
+	return a, errs
+}
+
+// mapperCopy method copies the source into destination as per profile, zero
+// value source is mapped into zero value destination.
+func mapperCopy(p *Profile, dst, src interface{}, opts []Option) []error {
+	if p.err == nil && isStruct(valueOf(src)) && isStructZero(indirect(valueOf(src))) {
+		return nil
+	}
+
+	return p.Copy(dst, src, opts...)
+}
@@ -0,0 +1,69 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+type mapperUser struct {
+	FullName  string
+	Email     string
+	Password  string
+	CreatedAt time.Time
+}
+
+type mapperUserDto struct {
+	Name      string
+	Email     string
+	Password  string
+	CreatedAt int64
+}
+
+func TestMapper(t *testing.T) {
+	m := NewMapper[mapperUser, mapperUserDto]().
+		Field("FullName", "Name").
+		Ignore("Password").
+		Convert((*time.Time)(nil), (*int64)(nil), func(in reflect.Value) (reflect.Value, error) {
+			return reflect.ValueOf(in.Interface().(time.Time).Unix()), nil
+		}).
+		Convert((*int64)(nil), (*time.Time)(nil), func(in reflect.Value) (reflect.Value, error) {
+			return reflect.ValueOf(time.Unix(in.Int(), 0).UTC()), nil
+		})
+
+	now := time.Date(2016, 8, 1, 10, 0, 0, 0, time.UTC)
+	user := mapperUser{FullName: "Jeeva", Email: "jeeva@myjeeva.com", Password: "secret", CreatedAt: now}
+
+	dto, errs := m.Map(user)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "Jeeva", dto.Name)
+	assertEqual(t, "jeeva@myjeeva.com", dto.Email)
+	assertEqual(t, "", dto.Password)
+	assertEqual(t, now.Unix(), dto.CreatedAt)
+
+	dto.Password = "secret"
+	back, errs := m.Reverse(dto)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "Jeeva", back.FullName)
+	assertEqual(t, "", back.Password)
+	assertEqual(t, true, back.CreatedAt.Equal(now))
+
+	// zero value
+	dto, errs = m.Map(mapperUser{})
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "", dto.Name)
+
+	// not a struct
+	_, errs = NewMapper[string, mapperUserDto]().Map("go-model")
+	assertEqual(t, "Source or Destination is not a struct", errs[0].Error())
+
+	// configuration error
+	_, errs = NewMapper[mapperUser, mapperUserDto]().Field("FullName", "NotExists").Map(user)
+	assertEqual(t, "Field: 'NotExists', does not exists", errs[0].Error())
+
+	assertEqual(t, true, Features()[FeatureGenerics])
+}
@@ -0,0 +1,158 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"reflect"
+	"strings"
+)
+
+// MaskWildcard is used in field mask path to match any field at that level.
+const MaskWildcard = "*"
+
+// WithFieldMask option constrains `Copy()` and `Map()` methods to exactly given
+// field paths, similar to `google.protobuf.FieldMask`. Path segment is either field
+// name or key name of the field, and `MaskWildcard` matches any field at that level.
+// Path selects the whole sub-tree of the field.
+// 		Example:
+//
+// 		// copies only user name and city of all addresses
+// 		errs := model.Copy(&dst, src, model.WithFieldMask("user.name", "user.addresses.*.city"))
+//
+// Note: Nested struct under mask is copied into existing destination struct,
+// so the fields outside of mask retain it's value.
+//
+func WithFieldMask(paths ...string) Option {
+	fm := newFieldMask(paths)
+	return func(o *options) {
+		o.mask = fm
+	}
+}
+
+// Only option keeps only the given fields in the `Map()` result, so the response
+// can be shaped without separate DTO struct. Path syntax is same as
+// `WithFieldMask()`, for eg.: "Address.City" keeps only city of the address.
+// 		Example:
+//
+// 		m, err := model.Map(user, model.Only("Name", "Email"))
+//
+func Only(paths ...string) Option {
+	return WithFieldMask(paths...)
+}
+
+// Except option leaves out the given fields from the `Map()` result. Path syntax
+// is same as `WithFieldMask()`, for eg.: "Address.Zip" leaves out only zip of the
+// address. It can be combined with `Only()` option.
+// 		Example:
+//
+// 		m, err := model.Map(user, model.Except("Password", "Addresses.*.Zip"))
+//
+func Except(paths ...string) Option {
+	fm := newFieldMask(paths)
+	return func(o *options) {
+		o.except = fm
+	}
+}
+
+// fieldMask is the remaining field mask paths at a traversal level, nil
+// fieldMask means everything is included.
+type fieldMask struct {
+	paths [][]string
+}
+
+func newFieldMask(paths []string) *fieldMask {
+	fm := &fieldMask{}
+	for _, p := range paths {
+		if isStringEmpty(p) {
+			continue
+		}
+		fm.paths = append(fm.paths, strings.Split(p, "."))
+	}
+
+	return fm
+}
+
+// child method returns the field mask for given field. It returns false if the
+// field is not included; nil field mask if the whole field is included.
+func (fm *fieldMask) child(f reflect.StructField, t *tag) (*fieldMask, bool) {
+	if fm == nil {
+		return nil, true
+	}
+
+	var sub [][]string
+	for _, p := range fm.paths {
+		if p[0] == MaskWildcard || p[0] == f.Name || p[0] == t.keyName(f) {
+			if len(p) == 1 {
+				return nil, true
+			}
+			sub = append(sub, p[1:])
+		}
+	}
+
+	// embedded struct fields are at same level as represented by Go
+	if f.Anonymous {
+		return fm, true
+	}
+
+	if len(sub) == 0 {
+		return nil, false
+	}
+
+	return &fieldMask{paths: sub}, true
+}
+
+// excluded method reports the given field is excluded as per exclusion mask,
+// otherwise it returns the exclusion mask for the field; nil if nothing is
+// excluded within the field.
+func (fm *fieldMask) excluded(f reflect.StructField, t *tag) (*fieldMask, bool) {
+	if fm == nil {
+		return nil, false
+	}
+
+	var sub [][]string
+	for _, p := range fm.paths {
+		if p[0] == MaskWildcard || p[0] == f.Name || p[0] == t.keyName(f) {
+			if len(p) == 1 {
+				return nil, true
+			}
+			sub = append(sub, p[1:])
+		}
+	}
+
+	// embedded struct fields are at same level as represented by Go
+	if f.Anonymous {
+		return fm, false
+	}
+
+	if len(sub) == 0 {
+		return nil, false
+	}
+
+	return &fieldMask{paths: sub}, false
+}
+
+// elem method returns the field mask for the elements of slice, array or map.
+// Path segment `MaskWildcard` at element level is consumed, otherwise the path
+// applies to element fields.
+func (fm *fieldMask) elem() *fieldMask {
+	if fm == nil {
+		return nil
+	}
+
+	sub := make([][]string, 0, len(fm.paths))
+	for _, p := range fm.paths {
+		if p[0] != MaskWildcard {
+			sub = append(sub, p)
+			continue
+		}
+
+		if len(p) == 1 {
+			return nil
+		}
+		sub = append(sub, p[1:])
+	}
+
+	return &fieldMask{paths: sub}
+}
@@ -12,12 +12,17 @@ import (
 	"net/http"
 	"os"
 	"reflect"
+	"sync"
 	"time"
 )
 
 // Converter is used to provide custom mappers for a datatype pair.
 type Converter func(in reflect.Value) (reflect.Value, error)
 
+// Predicate is used to decide whether the conditional `Converter` is applicable
+// for the input value.
+type Predicate func(in reflect.Value) bool
+
 const (
 	// TagName is used to mention field options for go-model library.
 	//
@@ -33,9 +38,57 @@ const (
 	// OmitEmpty option is used skip field(s) from output if it's zero value
 	OmitEmpty = "omitempty"
 
+	// OmitZero option is used skip field(s) from output if it's zero value as per
+	// it's `IsZero() bool` method, otherwise Go zero value of the type
+	OmitZero = "omitzero"
+
 	// NoTraverse option makes sure the go-model library to not to traverse inside the struct object.
 	// However, the field value will be evaluated or processed by library.
 	NoTraverse = "notraverse"
+
+	// Redact option is used to mark sensitive field(s), `Map()` method masks or
+	// omits those field values based on `RedactMode`
+	Redact = "redact"
+
+	// Expand option is used to split the composite source string into multiple
+	// destination fields and join them back in reverse direction, for eg.:
+	// `model:"fullName,expand=FirstName LastName"`
+	Expand = "expand"
+
+	// Expander option is used to choose the registered `Splitter` and `Joiner` for
+	// "expand" option, for eg.: `model:",expand=City State,expander=csv"`
+	Expander = "expander"
+
+	// Required option is used to mark field(s) as mandatory, `Validate()` method
+	// reports the field if it's zero value
+	Required = "required"
+
+	// Conv option is used to choose the named converter for the field, see
+	// `RegisterNamedConverter()`, for eg.: `model:"created,conv=unixtime"`
+	Conv = "conv"
+
+	// Append option is used to append the source slice elements to the existing
+	// destination slice instead of replacing it, for eg.: `model:"items,append"`
+	Append = "append"
+
+	// Merge option is used to merge the source map entries into the existing
+	// destination map instead of replacing it, for eg.: `model:"labels,merge"`
+	Merge = "merge"
+
+	// Inline option is used to hoist the nested struct fields into parent map
+	// same as embedded struct, for eg.: `model:",inline"`
+	Inline = "inline"
+
+	// Squash option is same as "inline" option, for compatibility with
+	// mapstructure, for eg.: `model:",squash"`
+	Squash = "squash"
+
+	// TimeFormat option is used to choose the layout of `time.Time` and string
+	// conversion of the field, `UnixTimeFormat` converts into unix seconds, for
+	// eg.: `model:"created,timefmt=2006-01-02"`. Layout cannot have comma, since
+	// options are comma separated; the layout constant name of `time` package
+	// is accepted instead, for eg.: `model:"created,timefmt=RFC1123"`
+	TimeFormat = "timefmt"
 )
 
 var (
@@ -48,6 +101,15 @@ var (
 	// Type conversion functions at library level
 	converterMap map[reflect.Type]map[reflect.Type]Converter
 
+	// Conditional type conversion functions at library level, in the order of registration
+	conditionalConverterMap map[reflect.Type]map[reflect.Type][]conditionalConverter
+
+	// registryMu guards the library level registries (no-traverse types, profiles,
+	// converters, bitmasks, zero checkers, handle types and expanders), so
+	// registration can happen at runtime while copy is in progress on other
+	// goroutines
+	registryMu sync.RWMutex
+
 	typeOfBytes     = reflect.TypeOf([]byte(nil))
 	typeOfInterface = reflect.TypeOf((*interface{})(nil)).Elem()
 )
@@ -60,7 +122,12 @@ var (
 // Default NoTraverseTypeList: time.Time{}, &time.Time{}, os.File{}, &os.File{},
 // http.Request{}, &http.Request{}, http.Response{}, &http.Response{}
 //
+// Note: It's safe to call at runtime from multiple goroutines.
+//
 func AddNoTraverseType(i ...interface{}) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
 	for _, v := range i {
 		t := reflect.TypeOf(v)
 		if _, ok := noTraverseTypeList[t]; ok {
@@ -79,6 +146,9 @@ func AddNoTraverseType(i ...interface{}) {
 // 		model.RemoveNoTraverseType(http.Request{}, &http.Request{})
 //
 func RemoveNoTraverseType(i ...interface{}) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
 	for _, v := range i {
 		t := reflect.TypeOf(v)
 		if _, ok := noTraverseTypeList[t]; ok {
@@ -90,7 +160,9 @@ func RemoveNoTraverseType(i ...interface{}) {
 }
 
 // AddConversion mothod allows registering a custom `Converter` into the global `converterMap`
-// by supplying pointers of the target types.
+// by supplying pointers of the target types. It's safe to call at runtime from multiple
+// goroutines, the conversion in progress uses the converter registered at the time of
+// field conversion.
 func AddConversion(in interface{}, out interface{}, converter Converter) {
 	srcType := extractType(in)
 	targetType := extractType(out)
@@ -99,16 +171,66 @@ func AddConversion(in interface{}, out interface{}, converter Converter) {
 
 // AddConversionByType allows registering a custom `Converter` into golbal `converterMap` by types.
 func AddConversionByType(srcType reflect.Type, targetType reflect.Type, converter Converter) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
 	if _, ok := converterMap[srcType]; !ok {
 		converterMap[srcType] = map[reflect.Type]Converter{}
 	}
 	converterMap[srcType][targetType] = converter
 }
 
-// RemoveConversion registered conversions
-func RemoveConversion(in interface{}, out interface{}) {
+// AddConditionalConversion method allows registering a custom `Converter` along with
+// `Predicate` by supplying pointers of the target types. Multiple conditional converters
+// can be registered for the same datatype pair; the first one whose `Predicate` returns
+// `true` for the input value is applied, in the order of registration. The converter
+// registered via `AddConversion()` is applied if none of the predicates matched.
+// 		Example:
+//
+// 		// accepts date string in multiple layouts
+// 		model.AddConditionalConversion((*string)(nil), (*time.Time)(nil),
+// 			func(in reflect.Value) bool { return len(in.String()) == 10 },
+// 			func(in reflect.Value) (reflect.Value, error) {
+// 				t, err := time.Parse("2006-01-02", in.String())
+// 				return reflect.ValueOf(t), err
+// 			})
+//
+func AddConditionalConversion(in interface{}, out interface{}, predicate Predicate, converter Converter) {
 	srcType := extractType(in)
 	targetType := extractType(out)
+	AddConditionalConversionByType(srcType, targetType, predicate, converter)
+}
+
+// AddConditionalConversionByType allows registering a custom `Converter` along with
+// `Predicate` by types. See also `AddConditionalConversion()` method.
+func AddConditionalConversionByType(srcType reflect.Type, targetType reflect.Type, predicate Predicate, converter Converter) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if _, ok := conditionalConverterMap[srcType]; !ok {
+		conditionalConverterMap[srcType] = map[reflect.Type][]conditionalConverter{}
+	}
+	conditionalConverterMap[srcType][targetType] = append(conditionalConverterMap[srcType][targetType],
+		conditionalConverter{predicate: predicate, converter: converter})
+}
+
+// RemoveConversion registered conversions, including the conditional and context-aware ones
+func RemoveConversion(in interface{}, out interface{}) {
+	RemoveConversionByType(extractType(in), extractType(out))
+}
+
+// RemoveConversionByType registered conversions by types, including the conditional
+// and context-aware ones
+func RemoveConversionByType(srcType reflect.Type, targetType reflect.Type) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if _, ok := conditionalConverterMap[srcType]; ok {
+		delete(conditionalConverterMap[srcType], targetType)
+	}
+	if _, ok := contextConverterMap[srcType]; ok {
+		delete(contextConverterMap[srcType], targetType)
+	}
 	if _, ok := converterMap[srcType]; !ok {
 		return
 	}
@@ -121,6 +243,9 @@ func RemoveConversion(in interface{}, out interface{}) {
 // IsZero method returns `true` if all the exported fields in a given `struct`
 // are zero value otherwise `false`. If input is not a struct, method returns `false`.
 //
+// Field value of the type which implements `IsZero() bool` method is evaluated
+// using that method, for eg.: `time.Time`, `decimal.Decimal`.
+//
 // A "model" tag with the value of "-" is ignored by library for processing.
 // 		Example:
 //
@@ -137,7 +262,12 @@ func RemoveConversion(in interface{}, out interface{}) {
 // 		ArchiveInfo	BookArchive	`model:"archiveInfo,notraverse"`
 // 		Region		BookLocale	`model:",notraverse"`
 //
-func IsZero(s interface{}) bool {
+// Use option `WithEmptyAsZero()` to treat zero length slice and map as zero value.
+// 		Example:
+//
+// 		result := model.IsZero(src, model.WithEmptyAsZero())
+//
+func IsZero(s interface{}, opts ...Option) bool {
 	if s == nil {
 		return true
 	}
@@ -147,42 +277,7 @@ func IsZero(s interface{}) bool {
 		return false
 	}
 
-	fields := modelFields(sv)
-
-	for _, f := range fields {
-		fv := sv.FieldByName(f.Name)
-		tag := newTag(f.Tag.Get(TagName))
-
-		if tag.isOmitField() {
-			continue
-		}
-
-		// embedded or nested struct
-		if isStruct(fv) {
-			// check type is in NoTraverseTypeList or has 'notraverse' tag option
-			if isNoTraverseType(fv) || tag.isNoTraverse() {
-
-				// not traversing inside, but evaluating a value
-				if !isFieldZero(fv) {
-					return false
-				}
-
-				continue
-			}
-
-			if !IsZero(fv.Interface()) {
-				return false
-			}
-
-			continue
-		}
-
-		if !isFieldZero(fv) {
-			return false
-		}
-	}
-
-	return true
+	return structZero(sv, nil, newOptions(opts))
 }
 
 // IsZeroInFields method verifies the value for the given list of field names against
@@ -230,6 +325,9 @@ func IsZeroInFields(s interface{}, names ...string) (string, bool) {
 // `struct` is zero value otherwise `false`. If input is not a struct, method
 // returns `false`.
 //
+// Field value of the type which implements `IsZero() bool` method is evaluated
+// using that method, for eg.: `time.Time`, `decimal.Decimal`.
+//
 // A "model" tag with the value of "-" is ignored by library for processing.
 // 		Example:
 //
@@ -246,7 +344,12 @@ func IsZeroInFields(s interface{}, names ...string) (string, bool) {
 // 		ArchiveInfo	BookArchive	`model:"archiveInfo,notraverse"`
 // 		Region		BookLocale	`model:",notraverse"`
 //
-func HasZero(s interface{}) bool {
+// Use option `WithEmptyAsZero()` to treat zero length slice and map as zero value.
+// 		Example:
+//
+// 		result := model.HasZero(src, model.WithEmptyAsZero())
+//
+func HasZero(s interface{}, opts ...Option) bool {
 	if s == nil {
 		return true
 	}
@@ -256,48 +359,14 @@ func HasZero(s interface{}) bool {
 		return false
 	}
 
-	fields := modelFields(sv)
-
-	for _, f := range fields {
-		fv := sv.FieldByName(f.Name)
-		tag := newTag(f.Tag.Get(TagName))
-
-		if tag.isOmitField() {
-			continue
-		}
-
-		// embedded or nested struct
-		if isStruct(fv) {
-			// check type is in NoTraverseTypeList or has 'notraverse' tag option
-			if isNoTraverseType(fv) || tag.isNoTraverse() {
-
-				// not traversing inside, but evaluating a value
-				if isFieldZero(fv) {
-					return true
-				}
-
-				continue
-			}
-
-			if HasZero(fv.Interface()) {
-				return true
-			}
-
-			continue
-		}
-
-		if isFieldZero(fv) {
-			return true
-		}
-	}
-
-	return false
+	return structHasZero(sv, nil, newOptions(opts))
 }
 
 // Copy method copies all the exported field values from source `struct` into destination `struct`.
 // The "Name", "Type" and "Kind" is should match to qualify a copy. One exception though;
 // if the destination field type is "interface{}" then "Type" and "Kind" doesn't matter,
-// source value gets copied to that destination field.
+// source value gets deep copied to that destination field, so nested pointers, slices
+// and maps of the concrete value are not shared with source.
 //
 // 		Example:
 //
@@ -312,7 +381,6 @@ func HasZero(s interface{}) bool {
 // Note:
 // [1] Copy process continues regardless of the case it qualifies or not. The non-qualified field(s)
 // gets added to '[]error' that you will get at the end.
-// [2] Two dimensional slice type is not supported yet.
 //
 // A "model" tag with the value of "-" is ignored by library for processing.
 // 		Example:
@@ -330,6 +398,17 @@ func HasZero(s interface{}) bool {
 // 		ArchiveInfo	BookArchive	`model:"archiveInfo,omitempty"`
 // 		Region		BookLocale	`model:",omitempty,notraverse"`
 //
+// A "model" tag value with the option of "omitzero"; library will not copy the value
+// if it's zero as per it's `IsZero() bool` method, otherwise Go zero value of the type.
+// Both options honor `IsZero() bool` method. However "omitempty" evaluates the struct
+// value without the method field by field, so the fields having "-" tag are ignored,
+// and `WithEmptyAsZero()` option applies to it. Whereas "omitzero" compares the value
+// as a whole with Go zero value, so the empty slice or map is not zero.
+// 		Example:
+//
+// 		// time.Time with location but zero instant is considered as zero
+// 		ExpiresAt	time.Time	`model:"expiresAt,omitzero"`
+//
 // A "model" tag value with the option of "notraverse"; library will not traverse
 // inside the struct object. However, the field value will be evaluated whether
 // it's zero value or not, and then copied to the destination object accordingly.
@@ -339,37 +418,91 @@ func HasZero(s interface{}) bool {
 // 		ArchiveInfo	BookArchive	`model:"archiveInfo,notraverse"`
 // 		Region		BookLocale	`model:",notraverse"`
 //
-func Copy(dst, src interface{}) []error {
+// A "model" tag value with the option of "expand"; library splits the source string
+// into listed destination fields. In the reverse direction, the listed source fields
+// are joined into destination field. By default it's whitespace, use "expander"
+// option to choose the registered one, see `AddExpander()`.
+// 		Example:
+//
+// 		// "Jeevanandam M" is copied into destination fields FirstName and LastName
+// 		FullName	string	`model:"fullName,expand=FirstName LastName"`
+//
+// A "model" tag value with the option of "append"; library appends the source slice
+// elements to the existing destination slice instead of replacing it. Use option
+// `WithAppend()` to append all the slice fields.
+// 		Example:
+//
+// 		// Source items are appended to destination items
+// 		Items	[]Item	`model:"items,append"`
+//
+// A "model" tag value with the option of "merge"; library merges the source map
+// entries into the existing destination map, source wins on key conflict. Use
+// option `WithMerge()` to merge all the map fields.
+// 		Example:
+//
+// 		// Source labels are merged into destination labels
+// 		Labels	map[string]string	`model:"labels,merge"`
+//
+// Use option `WithFieldMask()` to copy only the given field paths.
+// 		Example:
+//
+// 		errs := model.Copy(&dst, src, model.WithFieldMask("Name", "Address.City"))
+//
+// Copy method accepts the map as root input too, source map elements are deep copied
+// or converted into new destination map, for eg.: `map[string]Src` into `map[string]Dst`.
+// 		Example:
+//
+// 		var products map[string]Product
+// 		errs := model.Copy(&products, productDtos)
+//
+// Pointer and value fields of the same type are adapted, for eg.: source `Name string`
+// is copied into destination `Name *string` as new pointer and vice versa. Nil pointer
+// is copied as zero value.
+//
+// Self-referencing structure is supported, pointer which refers back to the
+// struct in copy process is copied as pointer to it's destination struct, for
+// eg.: `child.Parent` of copied tree refers the copied parent.
+//
+func Copy(dst, src interface{}, opts ...Option) []error {
 	var errs []error
 
-	if src == nil || dst == nil {
-		return append(errs, errors.New("Source or Destination is nil"))
-	}
-
-	sv := valueOf(src)
-	dv := valueOf(dst)
-
-	if !isStruct(sv) || !isStruct(dv) {
-		return append(errs, errors.New("Source or Destination is not a struct"))
-	}
-
-	if !isPtr(dv) {
-		return append(errs, errors.New("Destination struct is not a pointer"))
+	// map as root input
+	if isMapInput(dst) {
+		return copyRootMap(dst, src, newOptions(opts))
 	}
 
-	if IsZero(src) {
-		return append(errs, errors.New("Source struct is empty"))
+	if err := validateCopyInput(dst, src); err != nil {
+		return append(errs, err)
 	}
 
 	// processing, copy field value(s)
-	errs = doCopy(dv, sv)
+	o := newOptions(opts)
+	errs = doCopy(valueOf(dst), valueOf(src), newCopyState(o))
 	if len(errs) > 0 {
-		return errs
+		return o.limitErrors(errs)
 	}
 
 	return nil
 }
 
+// CopyE method is same as `Copy()` method, it returns the single error which
+// joins all the errors via `errors.Join`, otherwise nil. So it composes with
+// the standard error handling, individual errors are reachable via `errors.Is`
+// and `errors.As`.
+// 		Example:
+//
+// 		if err := model.CopyE(&dst, src); err != nil {
+// 			var fe *model.FieldError
+// 			if errors.As(err, &fe) {
+// 				fmt.Println("Failed field:", fe.Path)
+// 			}
+// 			return err
+// 		}
+//
+func CopyE(dst, src interface{}, opts ...Option) error {
+	return errors.Join(Copy(dst, src, opts...)...)
+}
+
 // Clone method creates a clone of given `struct` object. As you know go-model does, deep processing.
 // So all field values you get in the result.
 //
@@ -380,9 +513,6 @@ func Copy(dst, src interface{}) []error {
 //
 // 		fmt.Printf("\nCloned Object: %#v\n", clonedObj)
 //
-// Note:
-// [1] Two dimensional slice type is not supported yet.
-//
 // A "model" tag with the value of "-" is ignored by library for processing.
 // 		Example:
 //
@@ -407,7 +537,23 @@ func Copy(dst, src interface{}) []error {
 // 		ArchiveInfo	BookArchive	`model:"archiveInfo,notraverse"`
 // 		Region		BookLocale	`model:",notraverse"`
 //
-func Clone(s interface{}) (interface{}, error) {
+// Clone method accepts the map as root input too, the result is the map of same type.
+// 		Example:
+//
+// 		result, err := model.Clone(map[string]Product{"go-model": product})
+// 		products := result.(map[string]Product)
+//
+// Clone method accepts the `Option`(s) same as `Copy()` method.
+// 		Example:
+//
+// 		result, err := model.Clone(src, model.WithNoTraverseType(decimal.Decimal{}))
+//
+func Clone(s interface{}, opts ...Option) (interface{}, error) {
+	// map as root input
+	if isMapInput(s) {
+		return cloneRootMap(s, newOptions(opts)), nil
+	}
+
 	sv, err := structValue(s)
 	if err != nil {
 		return nil, err
@@ -419,8 +565,12 @@ func Clone(s interface{}) (interface{}, error) {
 	// create a target type
 	dv := reflect.New(st)
 
-	// apply copy to target
-	doCopy(dv, sv)
+	// apply copy to target, pointer input is supplied as is, so
+	// the cycle back to it refers the clone
+	if isPtr(valueOf(s)) {
+		sv = valueOf(s)
+	}
+	doCopy(dv, sv, newCopyState(newOptions(opts)))
 
 	return dv.Interface(), nil
 }
@@ -438,9 +588,6 @@ func Clone(s interface{}) (interface{}, error) {
 // 			fmt.Println("Error:", err)
 // 		}
 //
-// Note:
-// [1] Two dimensional slice type is not supported yet.
-//
 // The default 'Key Name' string is the struct field name. However, it can be
 // changed in the struct field's tag value via "model" tag.
 // 		Example:
@@ -463,6 +610,17 @@ func Clone(s interface{}) (interface{}, error) {
 // 		ArchivedDate	time.Time	`model:"archivedDate,omitempty"`
 // 		Region		BookLocale	`model:",omitempty,notraverse"`
 //
+// A "model" tag value with the option of "omitzero"; library will not include the value in map
+// if it's zero as per it's `IsZero() bool` method, otherwise Go zero value of the type.
+// Both options honor `IsZero() bool` method. However "omitempty" evaluates the struct
+// value without the method field by field, so the fields having "-" tag are ignored,
+// and `WithEmptyAsZero()` option applies to it. Whereas "omitzero" compares the value
+// as a whole with Go zero value, so the empty slice or map is not zero.
+// 		Example:
+//
+// 		// time.Time with location but zero instant is considered as zero
+// 		ExpiresAt	time.Time	`model:"expiresAt,omitzero"`
+//
 // A "model" tag value with the option of "notraverse"; library will not traverse
 // inside the struct object. However, the field value will be evaluated whether
 // it's zero value or not, and then added to the result map accordingly.
@@ -472,14 +630,74 @@ func Clone(s interface{}) (interface{}, error) {
 // 		ArchivedDate	time.Time	`model:"archivedDate,notraverse"`
 // 		Region		BookLocale	`model:",notraverse"`
 //
-func Map(s interface{}) (map[string]interface{}, error) {
+// A "model" tag value with the option of "redact"; library will mask the field value
+// with `RedactMaskValue` in the result map. Use option `WithRedact()` to omit the
+// field or include the actual value.
+// 		Example:
+//
+// 		// Field value appears as "***" in result map
+// 		Password	string	`model:"password,redact"`
+//
+// 		// Field is not included in result map
+// 		m, err := model.Map(src, model.WithRedact(model.RedactOmit))
+//
+// A "model" tag value with the option of "inline" (or "squash"); library will hoist
+// the nested struct field values into parent map, same as embedded struct.
+// `FromMap()` method looks up the nested struct fields at parent level too.
+// 		Example:
+//
+// 		// Address fields appear in the result map as "City", "Zip"
+// 		Address		Address		`model:",inline"`
+//
+// Use option `WithFieldMask()` to include only the given field paths.
+// 		Example:
+//
+// 		m, err := model.Map(src, model.WithFieldMask("Name", "Address.City"))
+//
+// Multi-level pointer field is mapped same as single level pointer, for eg.:
+// `**Address` field value appears as map in the result.
+//
+// Map method returns an error if the struct has reference cycle, since map
+// cannot represent it.
+// 		Example:
+//
+// 		// Output:
+// 		Field: 'Children[0].Parent', cycle detected
+//
+func Map(s interface{}, opts ...Option) (map[string]interface{}, error) {
 	sv, err := structValue(s)
 	if err != nil {
 		return nil, err
 	}
 
+	o := newOptions(opts)
+	o.mapping = newMapState(valueOf(s))
+
 	// processing, field value(s) into map
-	return doMap(sv), nil
+	m := doMap(sv, o)
+
+	// reference cycle cannot be represented in map
+	if o.mapping.failed() {
+		return nil, o.mapping.err
+	}
+
+	return m, nil
+}
+
+// MapShallow method converts only the top level of given struct into
+// `map[string]interface{}`; field values are kept as-is with their actual type,
+// for eg.: nested struct stays struct, slice stays typed slice and pointer stays
+// pointer. Embedded struct and nested struct with "inline" option are still
+// mapped at parent level. It accepts all the options of `Map()` method.
+// 		Example:
+//
+// 		src := SampleStruct { /* source struct field values go here */ }
+//
+// 		m, err := model.MapShallow(src)
+// 		addr := m["Address"].(Address)
+//
+func MapShallow(s interface{}, opts ...Option) (map[string]interface{}, error) {
+	return Map(s, append(opts, func(o *options) { o.shallow = true })...)
 }
 
 // Fields method returns the exported struct fields from the given `struct`.
@@ -499,7 +717,12 @@ func Fields(s interface{}) ([]reflect.StructField, error) {
 		return nil, err
 	}
 
-	return modelFields(sv), nil
+	// result is a copy of cached fields, so caller can modify it
+	fields := modelFields(sv)
+	result := make([]reflect.StructField, len(fields))
+	copy(result, fields)
+
+	return result, nil
 }
 
 // Kind method returns `reflect.Kind` for the given field name from the `struct`.
@@ -521,15 +744,17 @@ func Kind(s interface{}, name string) (reflect.Kind, error) {
 		return reflect.Invalid, err
 	}
 
-	fv, err := getField(sv, name)
-	if err != nil {
-		return reflect.Invalid, err
+	f, found := structField(sv.Type(), name)
+	if !found {
+		return reflect.Invalid, newError(ErrFieldNotFound, "Field: '%v', does not exists", name)
 	}
 
-	return fv.Type().Kind(), nil
+	return f.Type.Kind(), nil
 }
 
-// Get method returns a field value from `struct` by field name.
+// Get method returns a field value from `struct` by field name or path expression.
+// The path expression traverses nested struct, slice/array index and map key;
+// pointers are dereferenced automatically.
 // 		Example:
 //
 // 		src := SampleStruct {
@@ -543,6 +768,11 @@ func Kind(s interface{}, name string) (reflect.Kind, error) {
 // 		fmt.Println("Field Value:", value)
 // 		fmt.Println("Error:", err)
 //
+// 		// path expressions
+// 		value, err = model.Get(src, "ArchiveInfo.Location.Name")
+// 		value, err = model.Get(src, "Items[2].ID")
+// 		value, err = model.Get(src, "Meta[key]")
+//
 // Note: Get method does not honor model tag annotations. Get simply access
 // value on exported fields.
 //
@@ -552,15 +782,23 @@ func Get(s interface{}, name string) (interface{}, error) {
 		return nil, err
 	}
 
-	fv, err := getField(sv, name)
+	segs, err := parsePath(name)
 	if err != nil {
 		return nil, err
 	}
 
+	fv, err := getPath(sv, segs)
+	if err != nil {
+		return nil, fmt.Errorf("Field: '%v', %w", name, err)
+	}
+
 	return fv.Interface(), nil
 }
 
-// Set method sets a value into field on struct by field name.
+// Set method sets a value into field on struct by field name or path expression.
+// The path expression traverses nested struct, slice/array index and map key
+// (see `Get()` method); nil struct pointer, slice and map are allocated along the way.
+// Slice is grown up to the index, index beyond `MaxPathIndex` is reported as an error.
 // 		Example:
 //
 // 		src := SampleStruct {
@@ -579,42 +817,38 @@ func Get(s interface{}, name string) (interface{}, error) {
 // 		err := model.Set(&src, "Region", bookLocale)
 // 		fmt.Println("Error:", err)
 //
+// 		// path expression
+// 		err = model.Set(&src, "ArchiveInfo.Address.City", "Chennai")
+// 		fmt.Println("Error:", err)
+//
 // Note: Set method does not honor model tag annotations. Set simply given
 // value by field name on exported fields.
 //
 func Set(s interface{}, name string, value interface{}) error {
 	if s == nil {
-		return errors.New("Invalid input <nil>")
+		return newError(ErrNilInput, "Invalid input <nil>")
 	}
 
 	sv := valueOf(s)
 	if isPtr(sv) {
 		sv = sv.Elem()
 	} else {
-		return errors.New("Destination struct is not a pointer")
+		return newError(ErrNotPointer, "Destination struct is not a pointer")
 	}
 
-	fv, err := getField(sv, name)
+	segs, err := parsePath(name)
 	if err != nil {
 		return err
 	}
 
-	if !fv.CanSet() {
-		return fmt.Errorf("Field: %v, cannot be settable", name)
-	}
-
-	tv := valueOf(value)
-	if isPtr(tv) {
-		tv = tv.Elem()
-	}
+	if err = setPath(sv, segs, valueOf(value), "", assignExact); err != nil {
+		if err == errPathNotExists {
+			return newError(ErrFieldNotFound, "Field: '%v', does not exists", name)
+		}
 
-	if (fv.Kind() != tv.Kind()) || fv.Type() != tv.Type() {
-		return fmt.Errorf("Field: %v, type/kind did not match", name)
+		return fmt.Errorf("Field: %v, %w", name, err)
 	}
 
-	// assign the given value
-	fv.Set(tv)
-
 	return nil
 }
 
@@ -625,6 +859,7 @@ func Set(s interface{}, name string, value interface{}) error {
 func init() {
 	noTraverseTypeList = map[reflect.Type]bool{}
 	converterMap = map[reflect.Type]map[reflect.Type]Converter{}
+	conditionalConverterMap = map[reflect.Type]map[reflect.Type][]conditionalConverter{}
 
 	// Default NoTraverseTypeList
 	// --------------------------
@@ -648,118 +883,608 @@ func init() {
 // Non-exported methods of model library
 //
 
-func doCopy(dv, sv reflect.Value) []error {
-	dv = indirect(dv)
-	sv = indirect(sv)
-	fields := modelFields(sv)
+func isStructZero(sv reflect.Value) bool {
+	return structZero(sv, nil, nil)
+}
 
-	var errs []error
+// structZero method reports all the fields are zero value, pointer which is
+// already in traversal is considered as zero, so the cycle is not traversed again.
+// The visiting is allocated only if pointer is traversed.
+func structZero(sv reflect.Value, visiting map[visitKey]bool, o *options) bool {
+	ti := typeInfoOf(sv.Type())
+	tags := o.tagsOf(ti)
 
-	for _, f := range fields {
-		sfv := sv.FieldByName(f.Name)
-		tag := newTag(f.Tag.Get(TagName))
+	for i, f := range ti.fields {
+		fv := sv.FieldByIndex(f.Index)
+		tag := tags[i]
 
 		if tag.isOmitField() {
 			continue
 		}
 
-		// check type is in NoTraverseTypeList or has 'notraverse' tag option
-		noTraverse := (isNoTraverseType(sfv) || tag.isNoTraverse())
-
-		// check whether field is zero or not
-		var isVal bool
-		if isStruct(sfv) && !noTraverse {
-			isVal = !IsZero(sfv.Interface())
-		} else {
-			isVal = !isFieldZero(sfv)
-		}
+		// embedded or nested struct
+		if isStruct(fv) {
+			// check type is in NoTraverseTypeList or has 'notraverse' tag option
+			if isNoTraverseType(fv) || tag.isNoTraverse() {
 
-		// get dst field by name
-		dfv := dv.FieldByName(f.Name)
+				// not traversing inside, but evaluating a value
+				if !isFieldZero(fv) {
+					return false
+				}
 
-		// validate field - exists in dst, kind and type
-		err := validateCopyField(f, sfv, dfv)
-		if err != nil {
-			if err != errFieldNotExists {
-				errs = append(errs, err)
+				continue
 			}
 
-			continue
-		}
+			// type knows it's zero value, so not traversing inside
+			if zero, ok := zeroMethod(fv); ok {
+				if !zero {
+					return false
+				}
 
-		// if value is not exists
-		if !isVal {
-			// field value is zero and check 'omitempty' option present
-			// then don't copy into destination struct
-			// otherwise copy to dst
-			if !tag.isOmitEmpty() {
-				dfv.Set(zeroOf(dfv))
+				continue
 			}
-			continue
-		}
 
-		// check dst field settable or not
-		if dfv.CanSet() {
-			if isStruct(sfv) {
-				// handle embedded or nested struct
-				v, innerErrs := copyVal(dfv.Type(), sfv, noTraverse)
+			if isPtr(fv) && !fv.IsNil() {
+				key := visitKeyOf(fv)
+				if visiting[key] {
+					continue
+				}
 
-				// add errors to main stream
-				errs = append(errs, innerErrs...)
+				if visiting == nil {
+					visiting = map[visitKey]bool{}
+				}
+				visiting[key] = true
+			}
 
-				// handle based on ptr/non-ptr value
-				dfv.Set(v)
-			} else {
-				v, err := copyVal(dfv.Type(), sfv, false)
-				errs = append(errs, err...)
-				dfv.Set(v)
+			if !structZero(structOf(fv), visiting, o) {
+				return false
 			}
+
+			continue
+		}
+
+		if !o.isZero(fv) {
+			return false
 		}
 	}
 
-	return errs
+	return true
 }
 
-func doMap(sv reflect.Value) map[string]interface{} {
-	sv = indirect(sv)
-	fields := modelFields(sv)
-	m := map[string]interface{}{}
+func hasZero(sv reflect.Value) bool {
+	return structHasZero(sv, nil, nil)
+}
 
-	for _, f := range fields {
-		fv := sv.FieldByName(f.Name)
-		tag := newTag(f.Tag.Get(TagName))
+// structHasZero method reports any of the field is zero value, pointer which is
+// already in traversal is not traversed again.
+func structHasZero(sv reflect.Value, visiting map[visitKey]bool, o *options) bool {
+	ti := typeInfoOf(sv.Type())
+	tags := o.tagsOf(ti)
+
+	for i, f := range ti.fields {
+		fv := sv.FieldByIndex(f.Index)
+		tag := tags[i]
 
 		if tag.isOmitField() {
 			continue
 		}
 
-		// map key name
-		keyName := f.Name
-		if !isStringEmpty(tag.Name) {
-			keyName = tag.Name
-		}
+		// embedded or nested struct
+		if isStruct(fv) {
+			// check type is in NoTraverseTypeList or has 'notraverse' tag option
+			if isNoTraverseType(fv) || tag.isNoTraverse() {
 
-		// check type is in NoTraverseTypeList or has 'notraverse' tag option
-		noTraverse := (isNoTraverseType(fv) || tag.isNoTraverse())
+				// not traversing inside, but evaluating a value
+				if isFieldZero(fv) {
+					return true
+				}
 
-		// check whether field is zero or not
-		var isVal bool
+				continue
+			}
+
+			// type knows it's zero value, so not traversing inside
+			if zero, ok := zeroMethod(fv); ok {
+				if zero {
+					return true
+				}
+
+				continue
+			}
+
+			if isPtr(fv) && !fv.IsNil() {
+				key := visitKeyOf(fv)
+				if visiting[key] {
+					continue
+				}
+
+				if visiting == nil {
+					visiting = map[visitKey]bool{}
+				}
+				visiting[key] = true
+			}
+
+			if structHasZero(structOf(fv), visiting, o) {
+				return true
+			}
+
+			continue
+		}
+
+		if o.isZero(fv) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func doCopy(dv, sv reflect.Value, cs *copyState) []error {
+	return doCopyPlan(dv, sv, nil, cs)
+}
+
+// doCopyPlan method copies the source struct into destination struct as per
+// given plan, the plan of source and destination types is used if it's nil.
+func doCopyPlan(dv, sv reflect.Value, p *Plan, cs *copyState) []error {
+	// source type has full control over its own mapping
+	if copier, ok := copierOf(sv); ok {
+		return copyTo(dv, copier)
+	}
+
+	// root source pointer is in copy process too, so the cycle
+	// back to it reuses the destination pointer
+	if isPtr(sv) && isPtr(dv) && !sv.IsNil() {
+		key := visitKeyOf(sv)
+		if _, found := cs.visiting[key]; !found {
+			cs.visiting[key] = dv
+			defer delete(cs.visiting, key)
+		}
+		cs.copiedAs(key, dv)
+	}
+
+	dv = indirect(dv)
+	sv = indirect(sv)
+
+	if p == nil {
+		p = planOf(sv.Type(), dv.Type(), cs.opts.tagName())
+	}
+
+	errs := p.copy(dv, sv, cs)
+	if cs.stopped(errs) {
+		return cs.stoppedErrors(errs)
+	}
+
+	// unexported fields are copied only between identical struct types
+	if cs.opts.unexported && sv.Type() == dv.Type() {
+		errs = append(errs, copyUnexported(dv, sv, cs)...)
+		if cs.stopped(errs) {
+			return cs.stoppedErrors(errs)
+		}
+	}
+
+	// fields which are not exported on either side, copied via accessors
+	if cs.opts.accessors {
+		errs = append(errs, copyAccessors(dv, sv, cs)...)
+		if cs.stopped(errs) {
+			return cs.stoppedErrors(errs)
+		}
+	}
+
+	return errs
+}
+
+// copy method copies the source struct into destination struct as per plan.
+func (p *Plan) copy(dv, sv reflect.Value, cs *copyState) []error {
+	var errs []error
+
+	for _, pf := range p.fields {
+		if cs.stopped(errs) {
+			break
+		}
+
+		f, tag := pf.field, pf.tag
+		sfv := sv.FieldByIndex(f.Index)
+
+		// field is not within the field mask
+		mask, included := cs.opts.mask.child(f, tag)
+		if !included {
+			continue
+		}
+
+		// field is ignored or mapped from another field by profile
+		if cs.opts.profile != nil && pf.dstOwner != nil && cs.opts.profile.skipField(pf.dstKey()) {
+			continue
+		}
+
+		path := cs.fieldPath(f.Name)
+
+		// field is excluded at call time
+		if cs.opts.isSkipped(f, sfv) {
+			cs.record(reportSkipped, path)
+			continue
+		}
+
+		// field hook vetoes or transforms the source value
+		sfv, hooked, hookErr := cs.hookField(path, sfv, pf.dstField(dv, false))
+		if hookErr != nil {
+			errs = append(errs, newFieldError(f.Name, sfv.Type(), nil, hookErr, "%v", hookErr))
+			cs.record(reportFailed, path)
+			continue
+		}
+
+		if !hooked {
+			cs.record(reportSkipped, path)
+			continue
+		}
+
+		// field value is zero and has 'omitzero' option present
+		// then don't copy into destination struct
+		if tag.isOmitZero() && isZeroValue(sfv) {
+			cs.record(reportSkipped, path)
+			continue
+		}
+
+		// nil source pointer leaves the destination untouched
+		if cs.opts.skipNilPtr && isPtr(sfv) && sfv.IsNil() {
+			cs.record(reportSkipped, path)
+			continue
+		}
+
+		// nested struct beyond the max depth is not traversed
+		deep := isStruct(sfv) && !f.Anonymous && cs.opts.beyondDepth(cs.depth+1)
+
+		// check type is in NoTraverseTypeList or has 'notraverse' tag option,
+		// value holding handle is not traversed too
+		noTraverse := (cs.opts.isNoTraverseType(sfv) || tag.isNoTraverse() || isHandle(sfv) || deep)
+
+		// check whether field is zero or not
+		var isVal bool
+		if isStruct(sfv) && !noTraverse {
+			isVal = !isStructFieldZero(sfv, cs.opts)
+		} else {
+			isVal = !cs.opts.isZero(sfv)
+		}
+
+		// split composite source string into destination fields
+		if names, found := tag.expandFields(); found && (isVal || !tag.isOmitEmpty()) {
+			errs = append(errs, expandField(dv, sfv, f, tag, names)...)
+		}
+
+		// get dst field
+		// nil embedded pointer is allocated only for the value
+		dfv := pf.dstField(dv, isVal)
+
+		// field and named converters take precedence over type converters
+		if dfv.IsValid() && dfv.CanSet() {
+			converter, err := pf.converter(cs.opts, dfv.Type())
+			if err != nil {
+				errs = append(errs, newFieldError(f.Name, sfv.Type(), dfv.Type(), err, "%v", err))
+				cs.record(reportFailed, path)
+				continue
+			}
+
+			if converter != nil {
+				if isVal {
+					if err := convertField(dfv, sfv, f.Name, converter); err != nil {
+						errs = append(errs, err)
+						cs.record(reportFailed, path)
+					} else {
+						cs.record(reportConverted, path)
+					}
+				} else if !tag.isOmitEmpty() {
+					dfv.Set(zeroOf(dfv))
+					cs.record(reportCopied, path)
+				} else {
+					cs.record(reportSkipped, path)
+				}
+
+				continue
+			}
+		}
+
+		// validate field - exists in dst, kind and type
+		err := validateCopyField(f, sfv, dfv, cs.opts)
+		if err != nil {
+			if err != errFieldNotExists {
+				errs = append(errs, err)
+				cs.record(reportFailed, path)
+			}
+
+			continue
+		}
+
+		// embedded struct fields are traced and reported at embedded level
+		name := f.Name
+		if f.Anonymous && isStruct(sfv) && !noTraverse {
+			path, name = cs.path, ""
+		}
+
+		// partial field mask, nested struct is copied into existing destination struct
+		if mask != nil && isStruct(sfv) && !noTraverse && !cs.opts.conversionExists(sfv.Type(), dfv.Type()) {
+			leave, up := cs.enter(path), cs.deeper(f)
+			errs = append(errs, fieldErrors(name, sfv.Type(), dfv.Type(), copyMasked(dfv, sfv, mask, cs))...)
+			up()
+			leave()
+			continue
+		}
+
+		// slice is appended to the existing destination slice and
+		// map is merged into the existing destination map
+		appendTo := dfv.Kind() == reflect.Slice && (pf.appendTo || cs.opts.appendTo)
+		mergeTo := dfv.Kind() == reflect.Map && (pf.mergeTo || cs.opts.mergeTo)
+
+		// if value is not exists
+		if !isVal {
+			// field value is zero and check 'omitempty' option present
+			// then don't copy into destination struct
+			// otherwise copy to dst, nothing to append or merge
+			if tag.isOmitEmpty() || appendTo || mergeTo {
+				cs.record(reportSkipped, path)
+				continue
+			}
+
+			if ev, ok := cs.opts.emptyOf(dfv.Type()); ok {
+				dfv.Set(ev)
+			} else {
+				dfv.Set(zeroOf(dfv))
+			}
+			cs.record(reportCopied, path)
+			continue
+		}
+
+		// check dst field settable or not
+		if dfv.CanSet() {
+			restore := cs.withMask(mask)
+			leave, up := cs.enter(path), cs.deeper(f)
+
+			// traversed struct fields are reported individually
+			converted := cs.opts.conversionExists(sfv.Type(), dfv.Type()) || cs.opts.isConvertible(sfv.Type(), dfv.Type()) ||
+				isSQLConvertible(sfv.Type(), dfv.Type()) || isDurationConvertible(sfv.Type(), dfv.Type()) ||
+				isUUIDConvertible(sfv.Type(), dfv.Type())
+			traversed := isStruct(sfv) && !noTraverse && !converted
+
+			var (
+				v         reflect.Value
+				fieldErrs []error
+			)
+			if isStruct(sfv) {
+				// handle embedded or nested struct
+				v, fieldErrs = copyVal(dfv.Type(), sfv, noTraverse, cs)
+
+				// handle based on ptr/non-ptr value
+				if v.IsValid() {
+					dfv.Set(v)
+				}
+			} else {
+				v, fieldErrs = copyVal(dfv.Type(), sfv, false, cs)
+
+				// failed conversion doesn't produce a value
+				if v.IsValid() {
+					if appendTo {
+						v = reflect.AppendSlice(dfv, v)
+					}
+
+					// source wins on key conflict
+					if mergeTo && !dfv.IsNil() && v.Kind() == reflect.Map {
+						for _, key := range v.MapKeys() {
+							dfv.SetMapIndex(key, v.MapIndex(key))
+						}
+					} else {
+						dfv.Set(v)
+					}
+				}
+			}
+
+			// add errors to main stream with field path
+			errs = append(errs, fieldErrors(name, sfv.Type(), dfv.Type(), fieldErrs)...)
+
+			switch {
+			case traversed:
+			case len(fieldErrs) > 0:
+				cs.record(reportFailed, path)
+			case converted:
+				cs.record(reportConverted, path)
+			default:
+				cs.record(reportCopied, path)
+			}
+
+			up()
+			leave()
+			restore()
+		}
+	}
+
+	// join source fields into destination composite string
+	errs = append(errs, joinExpandFields(dv, sv, cs.opts)...)
+
+	return errs
+}
+
+// copyMasked method copies the source struct into existing destination struct
+// as per given field mask, nil destination pointer gets allocated.
+func copyMasked(dfv, sfv reflect.Value, mask *fieldMask, cs *copyState) []error {
+	if (isPtr(sfv) && sfv.IsNil()) || !dfv.CanSet() {
+		return nil
+	}
+
+	if isPtr(dfv) && dfv.IsNil() {
+		dfv.Set(reflect.New(dfv.Type().Elem()))
+	}
+
+	defer cs.withMask(mask)()
+	return doCopy(dfv, sfv, cs)
+}
+
+func doMap(sv reflect.Value, o *options) map[string]interface{} {
+	m := map[string]interface{}{}
+
+	// take care interface{} and its actual value
+	if isInterface(sv) {
+		sv = sv.Elem()
+	}
+
+	// pointer which is in traversal cannot be mapped
+	if isPtr(sv) {
+		if !o.mapping.enter(sv) {
+			return m
+		}
+		defer o.mapping.leave(sv)
+	}
+
+	sv = indirect(sv)
+	ti := typeInfoOf(sv.Type())
+	tags := o.mapTagsOf(ti)
+
+	o.mapping.push()
+	defer o.mapping.pop()
+
+	for i, f := range ti.fields {
+		if o.mapping.failed() {
+			return m
+		}
+
+		fv := singlePtr(sv.FieldByIndex(f.Index))
+		tag := tags[i]
+		o.mapping.field(f.Name)
+
+		if tag.isOmitField() {
+			continue
+		}
+
+		// field is not within the field mask
+		mask, included := o.mask.child(f, tag)
+		if !included {
+			continue
+		}
+
+		// field is left out by the exclusion mask
+		except, excluded := o.except.excluded(f, tag)
+		if excluded {
+			continue
+		}
+		fo := o.withMask(mask).withExcept(except)
+
+		// field is excluded at call time
+		if o.isSkipped(f, fv) {
+			continue
+		}
+
+		// field value is zero and has 'omitzero' option present
+		// then not include in the Map
+		if tag.isOmitZero() && isZeroValue(fv) {
+			continue
+		}
+
+		// map key name
+		keyName := o.keyNameOf(tag, f)
+
+		// sensitive field value is masked or omitted based on redact mode
+		if tag.isRedact() && o.redact != RedactNone {
+			if o.redact == RedactMask {
+				m[keyName] = RedactMaskValue
+			}
+
+			continue
+		}
+
+		// embedded struct and nested struct with 'inline' option are mapped at
+		// parent level
+		inline := f.Anonymous || tag.isInline()
+
+		// nested struct beyond the max depth is not traversed
+		deep := isStruct(fv) && !inline && o.beyondDepth(o.depth+1)
+		if !inline {
+			fo = fo.deeper()
+		}
+
+		// value is mapped as its text form
+		textual := o.textMarshal && isTextMarshaler(fv)
+
+		// check type is in NoTraverseTypeList or has 'notraverse' tag option
+		noTraverse := (o.isNoTraverseType(fv) || tag.isNoTraverse() || deep || textual)
+
+		// opaque struct value is mapped via its marshaler
+		marshaled := o.jsonMarshal && isStruct(fv) && (noTraverse || isOpaque(fv.Type()))
+		noTraverse = noTraverse || marshaled
+
+		// check whether field is zero or not
+		var isVal bool
 		if isStruct(fv) && !noTraverse {
-			isVal = !IsZero(fv.Interface())
+			isVal = !isStructFieldZero(fv, o)
 		} else {
-			isVal = !isFieldZero(fv)
+			isVal = !o.isZero(fv)
+		}
+
+		// nil embedded struct pointer gets mapped at embedded level
+		// with zero values of it's fields
+		if inline && !noTraverse && isPtr(fv) && fv.IsNil() && fv.Type().Elem().Kind() == reflect.Struct {
+			if !tag.isOmitEmpty() && o.nilPtr == NilPointerZero {
+				for k, v := range doMap(reflect.Zero(fv.Type().Elem()), fo) {
+					m[k] = v
+				}
+			}
+
+			continue
 		}
 
 		if !isVal {
 			// field value is zero and has 'omitempty' option present
 			// then not include in the Map
-			if !tag.isOmitEmpty() {
+			if tag.isOmitEmpty() {
+				continue
+			}
+
+			// nil pointer is included or omitted as per mode
+			if isPtr(fv) && fv.IsNil() && o.nilPtr != NilPointerZero {
+				if o.nilPtr == NilPointerNil {
+					m[keyName] = nil
+				}
+
+				continue
+			}
+
+			// zero value has text or marshaled form too
+			if (textual || marshaled) && !isPtr(fv) {
+				m[keyName] = mapVal(fv, true, fo).Interface()
+				continue
+			}
+
+			// nil pointer is dereferenced as zero value of element type
+			if isPtr(fv) && o.derefPtr {
+				m[keyName] = mapVal(reflect.Zero(fv.Type().Elem()), noTraverse, fo).Interface()
+				continue
+			}
+
+			if ev, ok := o.emptyOf(fv.Type()); ok {
+				m[keyName] = mapVal(ev, false, fo).Interface()
+			} else {
 				m[keyName] = zeroOf(fv).Interface()
 			}
 
 			continue
 		}
 
+		// shallow map has the field value as-is, except embedded struct
+		if o.shallow && !inline {
+			m[keyName] = sv.FieldByIndex(f.Index).Interface()
+			continue
+		}
+
+		// named converter of "conv" option
+		if name, found := tag.option(Conv); found {
+			if v, ok := mapConverted(fv, name); ok {
+				m[keyName] = v
+				continue
+			}
+		}
+
+		// time layout of "timefmt" option
+		if layout, found := tag.timeLayout(); found {
+			if v, ok := mapTimeFormatted(fv, layout); ok {
+				m[keyName] = v
+				continue
+			}
+		}
+
 		// handle embedded or nested struct
 		if isStruct(fv) {
 
@@ -767,13 +1492,13 @@ func doMap(sv reflect.Value) map[string]interface{} {
 				// This is struct kind and it's present in NoTraverseTypeList or
 				// has 'notraverse' tag option. So go-model is not gonna traverse inside.
 				// however will take care of field value
-				m[keyName] = mapVal(fv, true).Interface()
+				m[keyName] = mapVal(fv, true, fo).Interface()
 			} else {
 
 				// embedded struct values gets mapped at embedded level
 				// as represented by Go instead of object
-				fmv := doMap(fv)
-				if f.Anonymous {
+				fmv := doMap(fv, fo)
+				if inline {
 					for k, v := range fmv {
 						m[k] = v
 					}
@@ -785,49 +1510,198 @@ func doMap(sv reflect.Value) map[string]interface{} {
 			continue
 		}
 
-		m[keyName] = mapVal(fv, false).Interface()
+		m[keyName] = mapVal(fv, false, fo).Interface()
+	}
+
+	if o.unexported {
+		mapUnexported(m, sv, o)
+	}
+
+	if o.accessors {
+		mapAccessors(m, sv, o)
 	}
 
 	return m
 }
 
-func copyVal(dt reflect.Type, f reflect.Value, notraverse bool) (reflect.Value, []error) {
+func copyVal(dt reflect.Type, f reflect.Value, notraverse bool, cs *copyState) (reflect.Value, []error) {
 	var (
 		ptr  bool
+		key  visitKey
 		nf   reflect.Value
 		errs []error
 	)
 
-	if conversionExists(f.Type(), dt) && !notraverse {
+	// not traversed value is converted only into different type
+	if cs.opts.conversionExists(f.Type(), dt) && (!notraverse || f.Type() != dt) {
+		// context is done, remaining conversions are aborted
+		if cs.opts.ctx != nil && cs.opts.ctx.Err() != nil {
+			cs.aborted = true
+			return reflect.Value{}, append(errs, errConversionAborted)
+		}
+
 		// handle custom converters
-		res, err := converterMap[f.Type()][dt](f)
+		res, err := cs.opts.convertValue(f, dt)
 		if err != nil {
 			errs = append(errs, err)
 		}
 		return res, errs
 	}
 
+	// duration is converted from and into string
+	if isDurationConvertible(f.Type(), dt) {
+		v, err := convertDuration(f, dt)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		return v, errs
+	}
+
+	// UUID is converted from and into string
+	if isUUIDConvertible(f.Type(), dt) {
+		v, err := convertUUID(f, dt)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		return v, errs
+	}
+
+	// database value is copied via it's valuer or scanner
+	if isSQLConvertible(f.Type(), dt) {
+		v, err := sqlConvert(f, dt)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		return v, errs
+	}
+
+	// convertible value is converted into destination type, slice, array
+	// and map are converted by it's elements
+	if cs.opts.isConvertible(f.Type(), dt) && f.Kind() != reflect.Slice && f.Kind() != reflect.Map && f.Kind() != reflect.Array {
+		v, err := convert(f, dt)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		return v, errs
+	}
+
+	// value holding handle is never copied naively
+	if v, handled, err := copyHandle(f); handled {
+		if err != nil {
+			errs = append(errs, err)
+		}
+		return v, errs
+	}
+
 	// take care interface{} and its actual value
 	if isInterface(f) {
-		f = valueOf(f.Interface())
+		if f.IsNil() {
+			return reflect.Zero(dt), errs
+		}
+		f = f.Elem()
+
+		if v, handled, err := copyHandle(f); handled {
+			if err != nil {
+				errs = append(errs, err)
+			}
+			return v, errs
+		}
+	}
+
+	// destination interface{} holds the copy of source dynamic type
+	if isInterfaceType(dt) {
+		dt = f.Type()
+	}
+
+	// pointer and value of the same type are adapted, value is copied
+	// into new pointer and pointer is dereferenced
+	if dt.Kind() == reflect.Ptr && f.Type() == dt.Elem() {
+		v, err := copyVal(dt.Elem(), f, notraverse, cs)
+		errs = append(errs, err...)
+		if !v.IsValid() {
+			return v, errs
+		}
+
+		o := reflect.New(dt.Elem())
+		o.Elem().Set(v)
+		return o, errs
+	}
+
+	if isPtr(f) && f.Type().Elem() == dt {
+		if f.IsNil() {
+			return reflect.Zero(dt), errs
+		}
+
+		return copyVal(dt, f.Elem(), notraverse, cs)
 	}
 
 	// if ptr, let's take a note
 	if isPtr(f) {
+		if f.IsNil() {
+			return reflect.Zero(dt), errs
+		}
+
+		// pointer is already in copy process, it's a cycle
+		// so reuse the destination pointer
+		key = visitKeyOf(f)
+		if v, found := cs.visiting[key]; found && v.Type().AssignableTo(dt) {
+			return v, errs
+		}
+
+		// pointer is already copied, so aliasing is preserved
+		if v, found := cs.copied[key]; found && v.Type().AssignableTo(dt) {
+			return v, errs
+		}
+
+		// multi-level pointer is copied level by level, so
+		// the destination has the same depth
+		if isPtr(f.Elem()) {
+			et := dt
+			if et.Kind() == reflect.Ptr {
+				et = et.Elem()
+			}
+
+			v, err := copyVal(et, f.Elem(), notraverse, cs)
+			errs = append(errs, err...)
+			if !v.IsValid() {
+				return v, errs
+			}
+
+			o := reflect.New(v.Type())
+			o.Elem().Set(v)
+
+			cs.copiedAs(key, o)
+			return o, errs
+		}
+
 		ptr = true
 		f = f.Elem()
 	}
 
-	// two dimensional slice is not yet supported by this library
 	switch f.Kind() {
 	case reflect.Struct:
-		if notraverse {
+		if notraverse || cs.opts.beyondDepth(cs.depth) {
 			nf = f
 		} else {
-			nf = reflect.New(f.Type())
+			// element of different struct type is copied into destination type
+			st := f.Type()
+			if et := indirectType(dt); et.Kind() == reflect.Struct {
+				st = et
+			}
+			nf = reflect.New(st)
 
-			// currently, struct within map/slice errors doesn't get propagated
-			doCopy(nf, f)
+			if ptr {
+				cs.visiting[key] = nf
+				defer delete(cs.visiting, key)
+			}
+
+			errs = append(errs, doCopy(nf, f, cs)...)
+
+			// already a pointer, no need to wrap
+			if ptr {
+				cs.copiedAs(key, nf)
+				return nf, errs
+			}
 
 			// unwrap
 			nf = nf.Elem()
@@ -837,17 +1711,31 @@ func copyVal(dt reflect.Type, f reflect.Value, notraverse bool) (reflect.Value,
 			dt = dt.Elem()
 		}
 		nf = reflect.MakeMap(dt)
+		defer cs.withMask(cs.opts.mask.elem())()
 
 		for _, key := range f.MapKeys() {
+			if cs.stopped(errs) {
+				break
+			}
+
 			ov := f.MapIndex(key)
 
-			cv := reflect.New(dt.Elem()).Elem()
-			v, err := copyVal(dt.Elem(), ov, isNoTraverseType(ov))
+			nk, err := copyKey(dt.Key(), key, cs)
 			if len(err) > 0 {
 				errs = append(errs, err...)
-			} else {
+				continue
+			}
+
+			cv := reflect.New(dt.Elem()).Elem()
+			leave := cs.enterIndex(key)
+			v, err := copyVal(dt.Elem(), ov, cs.opts.isNoTraverseType(ov), cs)
+			leave()
+			errs = append(errs, elemErrors(key, ov.Type(), dt.Elem(), err)...)
+
+			// failed conversion doesn't produce a value
+			if v.IsValid() {
 				cv.Set(v)
-				nf.SetMapIndex(key, cv)
+				nf.SetMapIndex(nk, cv)
 			}
 		}
 	case reflect.Slice:
@@ -858,20 +1746,44 @@ func copyVal(dt reflect.Type, f reflect.Value, notraverse bool) (reflect.Value,
 				dt = dt.Elem()
 			}
 			nf = reflect.MakeSlice(dt, f.Len(), f.Cap())
+			defer cs.withMask(cs.opts.mask.elem())()
 
-			for i := 0; i < f.Len(); i++ {
+			for i := 0; i < f.Len() && !cs.stopped(errs); i++ {
 				ov := f.Index(i)
 
 				cv := reflect.New(dt.Elem()).Elem()
-				v, err := copyVal(dt.Elem(), ov, isNoTraverseType(ov))
-				if len(err) > 0 {
-					errs = append(errs, err...)
-				} else {
+				leave := cs.enterIndex(i)
+				v, err := copyVal(dt.Elem(), ov, cs.opts.isNoTraverseType(ov), cs)
+				leave()
+				errs = append(errs, elemErrors(i, ov.Type(), dt.Elem(), err)...)
+
+				// failed conversion doesn't produce a value
+				if v.IsValid() {
 					cv.Set(v)
 					nf.Index(i).Set(cv)
 				}
 			}
 		}
+	case reflect.Array:
+		if dt.Kind() == reflect.Ptr {
+			dt = dt.Elem()
+		}
+		nf = reflect.New(dt).Elem()
+		defer cs.withMask(cs.opts.mask.elem())()
+
+		for i := 0; i < f.Len() && i < nf.Len() && !cs.stopped(errs); i++ {
+			ov := f.Index(i)
+
+			leave := cs.enterIndex(i)
+			v, err := copyVal(dt.Elem(), ov, cs.opts.isNoTraverseType(ov), cs)
+			leave()
+			errs = append(errs, elemErrors(i, ov.Type(), dt.Elem(), err)...)
+
+			// failed conversion doesn't produce a value
+			if v.IsValid() {
+				nf.Index(i).Set(v)
+			}
+		}
 	default:
 		nf = f
 	}
@@ -881,13 +1793,94 @@ func copyVal(dt reflect.Type, f reflect.Value, notraverse bool) (reflect.Value,
 		o := reflect.New(nf.Type())
 		o.Elem().Set(nf)
 
+		cs.copiedAs(key, o)
 		return o, errs
 	}
 
 	return nf, errs
 }
 
-func mapVal(f reflect.Value, notraverse bool) reflect.Value {
+// mapElems method maps the slice or array elements recursively, the result has
+// same element type if all the mapped elements are assignable to it, otherwise
+// element type is interface{}.
+func mapElems(f reflect.Value, o *options) reflect.Value {
+	et := f.Type().Elem()
+	values := make([]reflect.Value, f.Len())
+
+	o.mapping.push()
+	defer o.mapping.pop()
+
+	for i := range values {
+		ev := f.Index(i)
+		if (isPtr(ev) || isInterface(ev)) && ev.IsNil() {
+			continue
+		}
+
+		o.mapping.index(i)
+		values[i] = mapVal(ev, o.isNoTraverseType(ev), o)
+		if !values[i].Type().AssignableTo(et) {
+			et = typeOfInterface
+		}
+	}
+
+	var nf reflect.Value
+	if f.Kind() == reflect.Array {
+		nf = reflect.New(reflect.ArrayOf(f.Len(), et)).Elem()
+	} else {
+		nf = reflect.MakeSlice(reflect.SliceOf(et), f.Len(), f.Len())
+	}
+
+	for i, v := range values {
+		if v.IsValid() {
+			nf.Index(i).Set(v)
+		}
+	}
+
+	return nf
+}
+
+// copyKey method deep copies the struct, pointer, array and interface map key,
+// scalar key of same type is used as-is.
+func copyKey(kt reflect.Type, key reflect.Value, cs *copyState) (reflect.Value, []error) {
+	switch key.Kind() {
+	case reflect.Struct, reflect.Ptr, reflect.Array, reflect.Interface:
+	default:
+		if key.Type() == kt {
+			return key, nil
+		}
+	}
+
+	nk, errs := copyVal(kt, key, cs.opts.isNoTraverseType(key), cs)
+	if len(errs) > 0 {
+		return nk, errs
+	}
+
+	// interface key holds the copy of dynamic value
+	if nk.IsValid() && !nk.Type().AssignableTo(kt) {
+		return nk, []error{newError(ErrTypeMismatch, "map key [%v] is not assignable to [%v]", nk.Type(), kt)}
+	}
+
+	return nk, nil
+}
+
+// mapTypedKeys method maps the given map value with original key values.
+func mapTypedKeys(f reflect.Value, o *options) reflect.Value {
+	nmv := map[interface{}]interface{}{}
+
+	o.mapping.push()
+	defer o.mapping.pop()
+
+	for _, key := range f.MapKeys() {
+		o.mapping.key(key)
+		mv := f.MapIndex(key)
+		nv := mapVal(mv, o.isNoTraverseType(mv), o)
+		nmv[key.Interface()] = nv.Interface()
+	}
+
+	return valueOf(nmv)
+}
+
+func mapVal(f reflect.Value, notraverse bool, o *options) reflect.Value {
 	var (
 		ptr bool
 		nf  reflect.Value
@@ -898,65 +1891,101 @@ func mapVal(f reflect.Value, notraverse bool) reflect.Value {
 		f = valueOf(f.Interface())
 	}
 
+	// multi-level pointer is mapped same as single level pointer
+	f = singlePtr(f)
+
+	// bit flags are mapped as flag names
+	if names, ok := flagNames(f); ok {
+		return valueOf(names)
+	}
+
+	// nil pointer element is mapped as-is, unless it's dereferenced
+	if isPtr(f) && f.IsNil() {
+		if o.derefPtr {
+			return mapVal(reflect.Zero(f.Type().Elem()), notraverse, o)
+		}
+
+		return f
+	}
+
+	// text marshaler value is mapped as its text form
+	if o.textMarshal {
+		if text, ok := marshalText(f); ok {
+			return valueOf(text)
+		}
+	}
+
+	// pointer or map which is in traversal cannot be mapped, struct pointer
+	// is tracked by doMap if it's traversed
+	if (isPtr(f) && f.Elem().Kind() != reflect.Struct) || (f.Kind() == reflect.Map && !f.IsNil()) {
+		if !o.mapping.enter(f) {
+			return f
+		}
+		defer o.mapping.leave(f)
+	}
+
 	// if ptr, let's take a note
+	pf := f
 	if isPtr(f) {
 		ptr = true
 		f = f.Elem()
 	}
 
-	// two dimensional slice is not yet supported by this library
 	switch f.Kind() {
 	case reflect.Struct:
-		if notraverse {
+		opaque := notraverse || o.beyondDepth(o.depth) || (o.jsonMarshal && isOpaque(f.Type()))
+
+		// opaque struct value is mapped via its marshaler
+		if opaque && o.jsonMarshal {
+			if v, ok := marshalOpaque(f); ok {
+				return valueOf(v)
+			}
+		}
+
+		if opaque {
 			nf = f
 		} else {
-			nf = valueOf(doMap(f))
+			nf = valueOf(doMap(pf, o))
 		}
 	case reflect.Map:
+		if o.typedKeys && f.Type().Key().Kind() != reflect.String {
+			nf = mapTypedKeys(f, o.elem())
+			break
+		}
+
 		nmv := map[string]interface{}{}
+		o = o.elem()
+
+		o.mapping.push()
+		defer o.mapping.pop()
 
 		for _, key := range f.MapKeys() {
-			skey := fmt.Sprintf("%v", key.Interface())
+			o.mapping.key(key)
+			skey := o.formatKey(key)
 			mv := f.MapIndex(key)
-			nv := mapVal(mv, isNoTraverseType(mv))
+			nv := mapVal(mv, o.isNoTraverseType(mv), o)
 			nmv[skey] = nv.Interface()
 		}
 
 		nf = valueOf(nmv)
 	case reflect.Slice:
-		if f.Type() == typeOfBytes {
+		if f.Type() == typeOfBytes || f.Len() == 0 {
 			nf = f
 		} else {
-			if f.Len() > 0 {
-				fsv := f.Index(0)
-
-				// figure out target slice type
-				if isStruct(fsv) {
-					nf = reflect.MakeSlice(reflect.SliceOf(typeOfInterface), f.Len(), f.Cap())
-				} else {
-					nf = reflect.MakeSlice(f.Type(), f.Len(), f.Cap())
-				}
-
-				for i := 0; i < f.Len(); i++ {
-					sv := f.Index(i)
-
-					var dv reflect.Value
-					if isStruct(sv) {
-						dv = reflect.New(typeOfInterface).Elem()
-					} else {
-						dv = reflect.New(sv.Type()).Elem()
-					}
-
-					dv.Set(mapVal(sv, isNoTraverseType(sv)))
-					nf.Index(i).Set(dv)
-				}
-			}
+			nf = mapElems(f, o.elem())
+		}
+	case reflect.Array:
+		// UUID is kept as-is
+		if isUUIDType(f.Type()) {
+			nf = f
+		} else {
+			nf = mapElems(f, o.elem())
 		}
 	default:
 		nf = f
 	}
 
-	if ptr {
+	if ptr && !o.derefPtr {
 		// wrap
 		o := reflect.New(nf.Type())
 		o.Elem().Set(nf)
@@ -5,11 +5,14 @@
 package model
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"reflect"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -2028,7 +2031,7 @@ func TestMapWithConverter(t *testing.T) {
 	errs := Copy(&b, &a)
 	assertEqual(t, a.M["1"].X, b.M["1"].X)
 	assertEqual(t, a.M["2"].X, b.M["2"].X)
-	assertEqual(t, "Custom conversion failed.", errs[0].Error())
+	assertEqual(t, "Field: 'M[3]', Custom conversion failed.", errs[0].Error())
 }
 
 func TestGetField(t *testing.T) {
@@ -2061,6 +2064,69 @@ func TestGetField(t *testing.T) {
 	assertEqual(t, "Invalid input <nil>", err.Error())
 }
 
+func TestGetFieldPath(t *testing.T) {
+	type Level2 struct {
+		Name string
+	}
+
+	type Level1 struct {
+		Level2 *Level2
+		Nil    *Level2
+	}
+
+	type Item struct {
+		ID int
+	}
+
+	type SampleStruct struct {
+		Level1 Level1
+		Items  []Item
+		Meta   map[string]interface{}
+		Counts map[int]*Item
+	}
+
+	src := &SampleStruct{
+		Level1: Level1{Level2: &Level2{Name: "go-model"}},
+		Items:  []Item{{ID: 101}, {ID: 102}, {ID: 103}},
+		Meta:   map[string]interface{}{"key": &Item{ID: 201}},
+		Counts: map[int]*Item{2: {ID: 301}},
+	}
+
+	value, err := Get(src, "Level1.Level2.Name")
+	assertError(t, err)
+	assertEqual(t, "go-model", value)
+
+	value, err = Get(src, "Items[2].ID")
+	assertError(t, err)
+	assertEqual(t, 103, value)
+
+	value, err = Get(src, "Meta[key].ID")
+	assertError(t, err)
+	assertEqual(t, 201, value)
+
+	value, err = Get(src, "Counts[2].ID")
+	assertError(t, err)
+	assertEqual(t, 301, value)
+
+	_, err = Get(src, "Level1.Nil.Name")
+	assertEqual(t, "Field: 'Level1.Nil.Name', cannot traverse nil value", err.Error())
+
+	_, err = Get(src, "Items[3].ID")
+	assertEqual(t, "Field: 'Items[3].ID', index '3' out of range", err.Error())
+
+	_, err = Get(src, "Meta[missing]")
+	assertEqual(t, "Field: 'Meta[missing]', key 'missing' does not exists", err.Error())
+
+	_, err = Get(src, "Level1.Level2.Missing")
+	assertEqual(t, "Field: 'Level1.Level2.Missing', does not exists", err.Error())
+
+	_, err = Get(src, "Level1[0]")
+	assertEqual(t, "Field: 'Level1[0]', cannot index [model.Level1]", err.Error())
+
+	_, err = Get(src, "Items[0")
+	assertEqual(t, "invalid path 'Items[0', missing ']'", err.Error())
+}
+
 func TestSetField(t *testing.T) {
 	type SampleStruct struct {
 		Int    int
@@ -2114,6 +2180,80 @@ func TestSetField(t *testing.T) {
 	assertEqual(t, "Field: String, type/kind did not match", err.Error())
 }
 
+func TestSetFieldPath(t *testing.T) {
+	type Address struct {
+		City string
+	}
+
+	type Item struct {
+		ID int
+	}
+
+	type SampleStruct struct {
+		Address  *Address
+		Items    []Item
+		Meta     map[string]*Address
+		Counts   map[string]int
+		Name     string
+		Settings interface{}
+	}
+
+	dst := SampleStruct{}
+
+	err := Set(&dst, "Address.City", "Chennai")
+	assertError(t, err)
+	assertEqual(t, "Chennai", dst.Address.City)
+
+	err = Set(&dst, "Items[1].ID", 102)
+	assertError(t, err)
+	assertEqual(t, 2, len(dst.Items))
+	assertEqual(t, 102, dst.Items[1].ID)
+
+	err = Set(&dst, "Meta[home].City", "Madurai")
+	assertError(t, err)
+	assertEqual(t, "Madurai", dst.Meta["home"].City)
+
+	err = Set(&dst, "Counts[total]", 10)
+	assertError(t, err)
+	assertEqual(t, 10, dst.Counts["total"])
+
+	err = Set(&dst, "Address.City", 10)
+	assertEqual(t, "Field: Address.City, type/kind did not match", err.Error())
+
+	err = Set(&dst, "Address.Zip", "600001")
+	assertEqual(t, "Field: 'Address.Zip', does not exists", err.Error())
+
+	err = Set(&dst, "Name.First", "Jeeva")
+	assertEqual(t, "Field: Name.First, cannot access 'First' on [string]", err.Error())
+
+	err = Set(&dst, "Settings.Name", "Jeeva")
+	assertEqual(t, "Field: Settings.Name, cannot traverse nil interface", err.Error())
+
+	// failed set leaves the destination untouched
+	dst = SampleStruct{}
+	err = Set(&dst, "Address.Zip", "600001")
+	assertEqual(t, "Field: 'Address.Zip', does not exists", err.Error())
+	assertEqual(t, true, dst.Address == nil)
+
+	err = Set(&dst, "Items[1].Name", "Book")
+	assertEqual(t, true, err != nil)
+	assertEqual(t, 0, len(dst.Items))
+
+	err = Set(&dst, "Meta[home].Zip", "600001")
+	assertEqual(t, true, err != nil)
+	assertEqual(t, true, dst.Meta == nil)
+
+	// slice is not grown beyond the maximum index
+	err = Set(&dst, "Items[999999999].ID", 1)
+	assertEqual(t, "Field: Items[999999999].ID, index '999999999' exceeds maximum index 1000", err.Error())
+	assertEqual(t, true, errors.Is(err, ErrFieldNotFound))
+	assertEqual(t, 0, len(dst.Items))
+
+	err = Set(&dst, fmt.Sprintf("Items[%d].ID", MaxPathIndex), 1)
+	assertError(t, err)
+	assertEqual(t, MaxPathIndex+1, len(dst.Items))
+}
+
 func TestImprovedCopy(t *testing.T) {
 	type DomainObject struct {
 		Name    string
@@ -2138,6 +2278,1759 @@ func TestImprovedCopy(t *testing.T) {
 	assertEqual(t, "go-model", dst.Name)
 }
 
+func TestMapRedact(t *testing.T) {
+	type Credential struct {
+		Username string  `model:"username"`
+		Password string  `model:"password,redact"`
+		Token    *string `model:"token,redact,omitempty"`
+	}
+
+	type SampleStruct struct {
+		Name       string
+		Credential Credential `model:"credential"`
+		Secret     Credential `model:"secret,redact"`
+	}
+
+	token := "abc"
+	src := SampleStruct{
+		Name:       "go-model",
+		Credential: Credential{Username: "jeeva", Password: "s3cr3t", Token: &token},
+		Secret:     Credential{Username: "admin", Password: "admin"},
+	}
+
+	// default mode is mask
+	result, err := Map(src)
+	assertError(t, err)
+	assertEqual(t, "go-model", result["Name"])
+	assertEqual(t, RedactMaskValue, result["secret"])
+
+	credential := result["credential"].(map[string]interface{})
+	assertEqual(t, "jeeva", credential["username"])
+	assertEqual(t, RedactMaskValue, credential["password"])
+	assertEqual(t, RedactMaskValue, credential["token"])
+
+	// omit mode
+	result, err = Map(src, WithRedact(RedactOmit))
+	assertError(t, err)
+	_, found := result["secret"]
+	assertEqual(t, false, found)
+
+	credential = result["credential"].(map[string]interface{})
+	assertEqual(t, 1, len(credential))
+	assertEqual(t, "jeeva", credential["username"])
+
+	// none mode
+	result, err = Map(src, WithRedact(RedactNone))
+	assertError(t, err)
+	credential = result["credential"].(map[string]interface{})
+	assertEqual(t, "s3cr3t", credential["password"])
+	assertEqual(t, "abc", *(credential["token"].(*string)))
+	assertEqual(t, "admin", result["secret"].(map[string]interface{})["password"])
+}
+
+func TestCopySliceInterfaceHeterogeneousStructs(t *testing.T) {
+	type Book struct {
+		Title string
+		Tags  []string
+	}
+
+	type Author struct {
+		Name  string
+		Books []interface{}
+	}
+
+	type Node struct {
+		Name     string
+		Children []interface{}
+	}
+
+	type SampleStruct struct {
+		Items []interface{}
+		Nodes []interface{}
+	}
+
+	author := &Author{Name: "Jeeva", Books: []interface{}{Book{Title: "go-model"}}}
+	root := &Node{Name: "root"}
+	root.Children = []interface{}{&Node{Name: "child"}, root}
+
+	src := SampleStruct{
+		Items: []interface{}{
+			Book{Title: "Go", Tags: []string{"lang"}},
+			author,
+			nil,
+			(*Book)(nil),
+			[]Book{{Title: "nested"}},
+			map[string]interface{}{"book": &Book{Title: "in map"}},
+			"string value",
+		},
+		Nodes: []interface{}{root},
+	}
+	dst := SampleStruct{}
+
+	errs := Copy(&dst, src)
+	assertEqual(t, true, errs == nil)
+	logSrcDst(t, src, dst)
+
+	assertEqual(t, 7, len(dst.Items))
+
+	book := dst.Items[0].(Book)
+	assertEqual(t, "Go", book.Title)
+	assertEqual(t, []string{"lang"}, book.Tags)
+	assertEqual(t, true, &book.Tags[0] != &src.Items[0].(Book).Tags[0])
+
+	dstAuthor := dst.Items[1].(*Author)
+	assertEqual(t, true, dstAuthor != author)
+	assertEqual(t, "Jeeva", dstAuthor.Name)
+	assertEqual(t, "go-model", dstAuthor.Books[0].(Book).Title)
+
+	assertEqual(t, true, dst.Items[2] == nil)
+	assertEqual(t, true, dst.Items[3].(*Book) == nil)
+	assertEqual(t, "nested", dst.Items[4].([]Book)[0].Title)
+
+	inMap := dst.Items[5].(map[string]interface{})["book"].(*Book)
+	assertEqual(t, "in map", inMap.Title)
+	assertEqual(t, true, inMap != src.Items[5].(map[string]interface{})["book"])
+	assertEqual(t, "string value", dst.Items[6])
+
+	// self reference does not recurse forever and refers the copied node
+	dstRoot := dst.Nodes[0].(*Node)
+	assertEqual(t, true, dstRoot != root)
+	assertEqual(t, "child", dstRoot.Children[0].(*Node).Name)
+	assertEqual(t, true, dstRoot.Children[1].(*Node) == dstRoot)
+}
+
+func TestConditionalConverter(t *testing.T) {
+	type SampleStructA struct {
+		Date string
+	}
+
+	type SampleStructB struct {
+		Date time.Time
+	}
+
+	AddConditionalConversion((*string)(nil), (*time.Time)(nil),
+		func(in reflect.Value) bool { return strings.Contains(in.String(), "-") },
+		func(in reflect.Value) (reflect.Value, error) {
+			t, err := time.Parse("2006-01-02", in.String())
+			return reflect.ValueOf(t), err
+		})
+	AddConditionalConversion((*string)(nil), (*time.Time)(nil),
+		func(in reflect.Value) bool { return strings.Contains(in.String(), "/") },
+		func(in reflect.Value) (reflect.Value, error) {
+			t, err := time.Parse("01/02/2006", in.String())
+			return reflect.ValueOf(t), err
+		})
+	defer RemoveConversion((*string)(nil), (*time.Time)(nil))
+
+	// first matching predicate
+	dst := SampleStructB{}
+	errs := Copy(&dst, SampleStructA{Date: "2018-08-27"})
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "2018-08-27", dst.Date.Format("2006-01-02"))
+
+	// second matching predicate
+	dst = SampleStructB{}
+	errs = Copy(&dst, SampleStructA{Date: "08/27/2018"})
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "2018-08-27", dst.Date.Format("2006-01-02"))
+
+	// none of the predicate matched and no fallback converter
+	dst = SampleStructB{}
+	errs = Copy(&dst, SampleStructA{Date: "Aug 27, 2018"})
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, "Field: 'Date', no converter is applicable for [string] to [time.Time]", errs[0].Error())
+	assertEqual(t, true, dst.Date.IsZero())
+
+	// fallback converter
+	AddConversion((*string)(nil), (*time.Time)(nil), func(in reflect.Value) (reflect.Value, error) {
+		t, err := time.Parse("Jan 2, 2006", in.String())
+		return reflect.ValueOf(t), err
+	})
+	errs = Copy(&dst, SampleStructA{Date: "Aug 27, 2018"})
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "2018-08-27", dst.Date.Format("2006-01-02"))
+
+	// conditional converters are removed along with fallback
+	RemoveConversion((*string)(nil), (*time.Time)(nil))
+	errs = Copy(&dst, SampleStructA{Date: "2018-08-27"})
+	assertEqual(t, "Field: 'Date', src [string] & dst [struct] kind didn't match", errs[0].Error())
+}
+
+func TestCopyFieldMask(t *testing.T) {
+	type Address struct {
+		City    string `model:"city"`
+		ZipCode string `model:"zipCode"`
+	}
+
+	type User struct {
+		Name      string    `model:"name"`
+		Email     string    `model:"email"`
+		Address   *Address  `model:"address"`
+		Addresses []Address `model:"addresses"`
+	}
+
+	src := User{
+		Name:      "go-model",
+		Email:     "new@example.com",
+		Address:   &Address{City: "Chennai", ZipCode: "600001"},
+		Addresses: []Address{{City: "Chennai", ZipCode: "600001"}, {City: "Madurai", ZipCode: "625001"}},
+	}
+
+	dst := User{
+		Name:    "old",
+		Email:   "old@example.com",
+		Address: &Address{City: "Coimbatore", ZipCode: "641001"},
+	}
+
+	errs := Copy(&dst, src, WithFieldMask("name", "Address.City", "addresses.*.city"))
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "go-model", dst.Name)
+	assertEqual(t, "old@example.com", dst.Email)
+	assertEqual(t, "Chennai", dst.Address.City)
+	assertEqual(t, "641001", dst.Address.ZipCode)
+	assertEqual(t, 2, len(dst.Addresses))
+	assertEqual(t, "Madurai", dst.Addresses[1].City)
+	assertEqual(t, "", dst.Addresses[1].ZipCode)
+
+	// nil destination pointer gets allocated
+	dst = User{}
+	errs = Copy(&dst, src, WithFieldMask("address.zipCode"))
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "", dst.Address.City)
+	assertEqual(t, "600001", dst.Address.ZipCode)
+
+	// masked zero value is copied
+	dst = User{Name: "old", Email: "old@example.com"}
+	errs = Copy(&dst, User{Name: "go-model"}, WithFieldMask("email"))
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "old", dst.Name)
+	assertEqual(t, "", dst.Email)
+}
+
+func TestMapFieldMask(t *testing.T) {
+	type Address struct {
+		City    string `model:"city"`
+		ZipCode string `model:"zipCode"`
+	}
+
+	type User struct {
+		Name      string    `model:"name"`
+		Email     string    `model:"email"`
+		Address   Address   `model:"address"`
+		Addresses []Address `model:"addresses"`
+	}
+
+	src := User{
+		Name:      "go-model",
+		Email:     "new@example.com",
+		Address:   Address{City: "Chennai", ZipCode: "600001"},
+		Addresses: []Address{{City: "Madurai", ZipCode: "625001"}},
+	}
+
+	result, err := Map(src, WithFieldMask("name", "address.city", "addresses.*.zipCode"))
+	assertError(t, err)
+	assertEqual(t, 3, len(result))
+	assertEqual(t, "go-model", result["name"])
+
+	address := result["address"].(map[string]interface{})
+	assertEqual(t, 1, len(address))
+	assertEqual(t, "Chennai", address["city"])
+
+	addresses := result["addresses"].([]interface{})
+	item := addresses[0].(map[string]interface{})
+	assertEqual(t, 1, len(item))
+	assertEqual(t, "625001", item["zipCode"])
+
+	// wildcard includes every field at that level
+	result, err = Map(src, WithFieldMask("*"))
+	assertError(t, err)
+	assertEqual(t, 4, len(result))
+}
+
+type sampleMoney struct {
+	Amount   int
+	Currency string
+}
+
+func (m sampleMoney) IsZero() bool {
+	return m.Amount == 0
+}
+
+func TestCopyAndMapOmitZero(t *testing.T) {
+	type SampleStruct struct {
+		Name      string      `model:"name,omitzero"`
+		Price     sampleMoney `model:"price,omitzero"`
+		Discount  sampleMoney `model:"discount,omitempty"`
+		ExpiresAt time.Time   `model:"expiresAt,omitzero"`
+		Tags      []string    `model:"tags,omitzero"`
+	}
+
+	zeroInstant := time.Time{}.In(time.FixedZone("IST", 19800))
+	src := SampleStruct{
+		Price:     sampleMoney{Currency: "INR"},
+		Discount:  sampleMoney{Currency: "INR"},
+		ExpiresAt: zeroInstant,
+		Tags:      []string{},
+	}
+
+	dst := SampleStruct{
+		Name:      "go-model",
+		Price:     sampleMoney{Amount: 100, Currency: "USD"},
+		ExpiresAt: time.Now(),
+	}
+	errs := Copy(&dst, src)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "go-model", dst.Name)
+	assertEqual(t, 100, dst.Price.Amount)
+	// IsZero method is honored by omitempty too
+	assertEqual(t, "", dst.Discount.Currency)
+	assertEqual(t, false, dst.ExpiresAt.IsZero())
+	assertEqual(t, 0, len(dst.Tags))
+	assertEqual(t, true, dst.Tags != nil)
+
+	src.Tags = nil
+	result, err := Map(src)
+	assertError(t, err)
+	assertEqual(t, 0, len(result))
+	_, found := result["price"]
+	assertEqual(t, false, found)
+	_, found = result["discount"]
+	assertEqual(t, false, found)
+}
+
+func TestCopyNoTraverseTypeWithConverter(t *testing.T) {
+	AddConversion((*time.Time)(nil), (*string)(nil), func(in reflect.Value) (reflect.Value, error) {
+		return reflect.ValueOf(in.Interface().(time.Time).Format("2006-01-02")), nil
+	})
+	defer RemoveConversion((*time.Time)(nil), (*string)(nil))
+
+	type Source struct {
+		CreatedAt time.Time
+	}
+
+	type Destination struct {
+		CreatedAt string
+	}
+
+	dst := Destination{}
+	errs := Copy(&dst, Source{CreatedAt: time.Date(2016, 8, 1, 0, 0, 0, 0, time.UTC)})
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "2016-08-01", dst.CreatedAt)
+}
+
+func TestRegistryConcurrentAccess(t *testing.T) {
+	type Decimal struct {
+		Unscaled int64
+		Scale    int
+	}
+
+	type Source struct {
+		Amount    Decimal
+		CreatedAt time.Time
+		Location  string `model:",expand=City State,expander=concurrent"`
+	}
+
+	type Destination struct {
+		Amount    string
+		CreatedAt time.Time
+		City      string
+		State     string
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				AddConversion((*Decimal)(nil), (*string)(nil), func(in reflect.Value) (reflect.Value, error) {
+					return reflect.ValueOf(strconv.FormatInt(in.Field(0).Int(), 10)), nil
+				})
+				AddNoTraverseType(Decimal{})
+				RemoveNoTraverseType(Decimal{})
+				AddNoTraverseProfile("concurrent", Decimal{})
+				RemoveNoTraverseProfile("concurrent")
+				AddHandleType(HandleShare, Decimal{})
+				RemoveHandleType(Decimal{})
+				AddExpander("concurrent", splitBySpace, joinBySpace)
+				RemoveExpander("concurrent")
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				dst := Destination{}
+				_ = Copy(&dst, Source{Amount: Decimal{Unscaled: 1050, Scale: 2}, CreatedAt: time.Now(), Location: "Chennai TN"},
+					WithNoTraverseProfile("concurrent"))
+				_, _ = Map(Source{CreatedAt: time.Now()})
+			}
+		}()
+	}
+	wg.Wait()
+
+	RemoveConversion((*Decimal)(nil), (*string)(nil))
+}
+
+func TestCopyWithAutoConvert(t *testing.T) {
+	type UserID int64
+	type Role string
+
+	type Source struct {
+		ID       UserID
+		Score    float32
+		Role     Role
+		Roles    []Role
+		Limits   map[string]int32
+		Code     int
+		Name     string
+		Previous *UserID
+	}
+
+	type Destination struct {
+		ID       int64
+		Score    float64
+		Role     string
+		Roles    []string
+		Limits   map[string]int64
+		Code     string
+		Name     []byte
+		Previous *int64
+	}
+
+	previous := UserID(10)
+	src := Source{
+		ID:       UserID(101),
+		Score:    4.5,
+		Role:     Role("admin"),
+		Roles:    []Role{"admin", "user"},
+		Limits:   map[string]int32{"daily": 100},
+		Code:     65,
+		Name:     "go-model",
+		Previous: &previous,
+	}
+
+	dst := Destination{}
+	errs := Copy(&dst, src, WithAutoConvert())
+	assertEqual(t, 2, len(errs))
+	assertEqual(t, "Field: 'Code', src [int] & dst [string] kind didn't match", errs[0].Error())
+	assertEqual(t, "Field: 'Previous', src [*model.UserID] & dst [*int64] type didn't match", errs[1].Error())
+	assertEqual(t, int64(101), dst.ID)
+	assertEqual(t, float64(4.5), dst.Score)
+	assertEqual(t, "admin", dst.Role)
+	assertEqual(t, []string{"admin", "user"}, dst.Roles)
+	assertEqual(t, map[string]int64{"daily": 100}, dst.Limits)
+	assertEqual(t, "", dst.Code)
+	assertEqual(t, []byte("go-model"), dst.Name)
+	assertEqual(t, true, dst.Previous == nil)
+
+	// registered converter takes precedence
+	AddConversion((*float32)(nil), (*float64)(nil), func(in reflect.Value) (reflect.Value, error) {
+		return reflect.ValueOf(in.Float() * 2), nil
+	})
+	defer RemoveConversion((*float32)(nil), (*float64)(nil))
+
+	dst = Destination{}
+	_ = Copy(&dst, src, WithAutoConvert())
+	assertEqual(t, float64(9), dst.Score)
+
+	// without option
+	dst = Destination{}
+	errs = Copy(&dst, Source{ID: UserID(101)})
+	assertEqual(t, "Field: 'ID', src [model.UserID] & dst [int64] type didn't match", errs[0].Error())
+	assertEqual(t, int64(0), dst.ID)
+}
+
+func TestArraySupport(t *testing.T) {
+	type Item struct {
+		Name string
+		Tags []string
+	}
+
+	type SampleStruct struct {
+		Items  [2]Item
+		Ptrs   [2]*Item
+		Counts [3]int32
+		Any    [2]interface{}
+	}
+
+	src := SampleStruct{
+		Items:  [2]Item{{Name: "a", Tags: []string{"x"}}, {Name: "b"}},
+		Ptrs:   [2]*Item{{Name: "p"}, nil},
+		Counts: [3]int32{1, 2, 3},
+		Any:    [2]interface{}{Item{Name: "i"}, nil},
+	}
+
+	// copy
+	dst := SampleStruct{}
+	errs := Copy(&dst, src)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "a", dst.Items[0].Name)
+	assertEqual(t, "p", dst.Ptrs[0].Name)
+	assertEqual(t, false, dst.Ptrs[0] == src.Ptrs[0])
+	assertEqual(t, true, dst.Ptrs[1] == nil)
+	assertEqual(t, int32(3), dst.Counts[2])
+
+	src.Items[0].Tags[0] = "modified"
+	assertEqual(t, "x", dst.Items[0].Tags[0])
+
+	// clone
+	result, err := Clone(src)
+	assertError(t, err)
+	cloned := result.(*SampleStruct)
+	assertEqual(t, "p", cloned.Ptrs[0].Name)
+	assertEqual(t, false, cloned.Ptrs[0] == src.Ptrs[0])
+
+	// map
+	m, err := Map(src)
+	assertError(t, err)
+	items := m["Items"].([2]interface{})
+	assertEqual(t, "a", items[0].(map[string]interface{})["Name"])
+	assertEqual(t, true, m["Counts"].([3]int32) == [3]int32{1, 2, 3})
+	assertEqual(t, "i", m["Any"].([2]interface{})[0].(map[string]interface{})["Name"])
+
+	// element conversion
+	type Widened struct {
+		Counts [3]int64
+	}
+
+	wdst := Widened{}
+	errs = Copy(&wdst, src, WithNumericConvert())
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, int64(2), wdst.Counts[1])
+
+	// zero
+	assertEqual(t, true, IsZero(SampleStruct{}))
+	assertEqual(t, false, IsZero(SampleStruct{Ptrs: [2]*Item{nil, {}}}))
+}
+
+func TestNestedSliceSupport(t *testing.T) {
+	type Cell struct {
+		Value string
+	}
+
+	type Source struct {
+		Matrix [][]int
+		Grid   [][]Cell
+		Prices [][]float64
+		Groups []map[string][]float64
+		Empty  []string
+	}
+
+	type Destination struct {
+		Matrix [][]int
+		Grid   [][]Cell
+		Prices [][]string
+		Groups []map[string][]string
+		Empty  []string
+	}
+
+	AddConversion((*float64)(nil), (*string)(nil), func(in reflect.Value) (reflect.Value, error) {
+		return reflect.ValueOf(strconv.FormatFloat(in.Float(), 'f', 1, 64)), nil
+	})
+	defer RemoveConversion((*float64)(nil), (*string)(nil))
+
+	src := Source{
+		Matrix: [][]int{{1, 2}, {3}},
+		Grid:   [][]Cell{{{Value: "a"}}, {{Value: "b"}, {Value: "c"}}},
+		Prices: [][]float64{{1.5}, {2, 3}},
+		Groups: []map[string][]float64{{"daily": {10}}},
+		Empty:  []string{},
+	}
+
+	dst := Destination{}
+	errs := Copy(&dst, src)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, []int{1, 2}, dst.Matrix[0])
+	assertEqual(t, "c", dst.Grid[1][1].Value)
+	assertEqual(t, []string{"2.0", "3.0"}, dst.Prices[1])
+	assertEqual(t, []string{"10.0"}, dst.Groups[0]["daily"])
+
+	src.Matrix[0][0] = 10
+	assertEqual(t, 1, dst.Matrix[0][0])
+
+	m, err := Map(src)
+	assertError(t, err)
+	assertEqual(t, []int{3}, m["Matrix"].([][]int)[1])
+	grid := m["Grid"].([]interface{})
+	assertEqual(t, "b", grid[1].([]interface{})[0].(map[string]interface{})["Value"])
+	assertEqual(t, []float64{10}, m["Groups"].([]interface{})[0].(map[string]interface{})["daily"].([]float64))
+	assertEqual(t, 0, len(m["Empty"].([]string)))
+}
+
+func TestStructMapKeys(t *testing.T) {
+	type GeoPoint struct {
+		Lat, Lng float64
+	}
+
+	type Tag struct {
+		Name string
+	}
+
+	type SampleStruct struct {
+		Places map[GeoPoint]string
+		Tags   map[*Tag]int
+		Names  map[interface{}]string
+	}
+
+	tag := &Tag{Name: "go"}
+	src := SampleStruct{
+		Places: map[GeoPoint]string{{Lat: 13.08, Lng: 80.27}: "Chennai"},
+		Tags:   map[*Tag]int{tag: 1},
+		Names:  map[interface{}]string{GeoPoint{Lat: 1}: "one", "two": "two"},
+	}
+
+	dst := SampleStruct{}
+	errs := Copy(&dst, src)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "Chennai", dst.Places[GeoPoint{Lat: 13.08, Lng: 80.27}])
+	assertEqual(t, "one", dst.Names[GeoPoint{Lat: 1}])
+	assertEqual(t, "two", dst.Names["two"])
+	assertEqual(t, 1, len(dst.Tags))
+	for k, v := range dst.Tags {
+		assertEqual(t, false, k == tag)
+		assertEqual(t, "go", k.Name)
+		assertEqual(t, 1, v)
+	}
+
+	// default key formatting
+	m, err := Map(src)
+	assertError(t, err)
+	assertEqual(t, "Chennai", m["Places"].(map[string]interface{})["{13.08 80.27}"])
+	assertEqual(t, 1, m["Tags"].(map[string]interface{})["{go}"])
+
+	// custom key formatting
+	m, err = Map(src, WithMapKeyFormatter(func(key reflect.Value) string {
+		if p, ok := key.Interface().(GeoPoint); ok {
+			return fmt.Sprintf("%v,%v", p.Lat, p.Lng)
+		}
+		return fmt.Sprint(key.Interface())
+	}))
+	assertError(t, err)
+	assertEqual(t, "Chennai", m["Places"].(map[string]interface{})["13.08,80.27"])
+	assertEqual(t, "one", m["Names"].(map[string]interface{})["1,0"])
+}
+
+type sampleNode struct {
+	Name     string
+	Parent   *sampleNode
+	Children []*sampleNode
+}
+
+func TestCycleSupport(t *testing.T) {
+	root := &sampleNode{Name: "root"}
+	child := &sampleNode{Name: "child", Parent: root}
+	root.Children = []*sampleNode{child}
+
+	// copy reuses the already copied node
+	dst := sampleNode{}
+	errs := Copy(&dst, root)
+	assertError(t, errors.Join(errs...))
+	assertEqual(t, "child", dst.Children[0].Name)
+	assertEqual(t, true, dst.Children[0].Parent == &dst)
+	assertEqual(t, true, dst.Children[0] != child)
+
+	cloned, err := Clone(root)
+	assertError(t, err)
+	cr := cloned.(*sampleNode)
+	assertEqual(t, true, cr.Children[0].Parent == cr)
+	assertEqual(t, true, cr != root)
+
+	// zero check terminates
+	empty := &sampleNode{}
+	empty.Parent = empty
+	assertEqual(t, true, IsZero(empty))
+	assertEqual(t, true, HasZero(root))
+
+	// map cannot represent the cycle
+	_, err = Map(root)
+	assertEqual(t, "Field: 'Children[0].Parent', cycle detected", err.Error())
+
+	_, err = MapSlice([]*sampleNode{root})
+	assertEqual(t, "Field: 'Children[0].Parent', cycle detected", err.Error())
+
+	// shared pointer without cycle is mapped
+	shared := &sampleNode{Name: "shared"}
+	m, err := Map(sampleNode{Name: "a", Children: []*sampleNode{shared, shared}})
+	assertError(t, err)
+	assertEqual(t, 2, len(m["Children"].([]interface{})))
+}
+
+func TestMapCycleOptions(t *testing.T) {
+	root := &sampleNode{Name: "root"}
+	child := &sampleNode{Name: "child", Parent: root}
+	root.Children = []*sampleNode{child}
+
+	// type which is not traversed in the call is not a cycle
+	m, err := Map(root, WithNoTraverseType(&sampleNode{}))
+	assertError(t, err)
+	assertEqual(t, "child", m["Children"].([]*sampleNode)[0].Name)
+
+	// field which is not mapped is not a cycle
+	_, err = Map(root, Except("Children"))
+	assertError(t, err)
+
+	// cycle via interface and map value
+	type Holder struct {
+		Name string
+		Meta map[string]interface{}
+		Any  interface{}
+	}
+
+	meta := map[string]interface{}{"name": "meta"}
+	meta["self"] = meta
+	_, err = Map(Holder{Name: "go-model", Meta: meta})
+	assertEqual(t, "Field: 'Meta[self]', cycle detected", err.Error())
+
+	h := &Holder{Name: "go-model"}
+	h.Any = []interface{}{h}
+	_, err = Map(h)
+	assertEqual(t, "Field: 'Any[0]', cycle detected", err.Error())
+
+	h.Any = h
+	_, err = Map(h)
+	assertEqual(t, "Field: 'Any', cycle detected", err.Error())
+
+	// interface value without cycle is mapped
+	m, err = Map(Holder{Name: "go-model", Any: &Holder{Name: "inner"}})
+	assertError(t, err)
+	assertEqual(t, "inner", m["Any"].(map[string]interface{})["Name"])
+}
+
+func TestPreserveAliasing(t *testing.T) {
+	type Address struct {
+		City string
+	}
+
+	type Order struct {
+		Billing   *Address
+		Shipping  *Address
+		Addresses []*Address
+		Count     *int
+		Total     *int
+	}
+
+	addr := &Address{City: "Chennai"}
+	count := 2
+	src := &Order{Billing: addr, Shipping: addr, Addresses: []*Address{addr}, Count: &count, Total: &count}
+
+	// by default every pointer gets it's own copy
+	result, err := Clone(src)
+	assertError(t, err)
+	order := result.(*Order)
+	assertEqual(t, false, order.Billing == order.Shipping)
+	assertEqual(t, false, order.Count == order.Total)
+
+	result, err = Clone(src, WithPreserveAliasing())
+	assertError(t, err)
+	order = result.(*Order)
+	assertEqual(t, true, order.Billing == order.Shipping)
+	assertEqual(t, true, order.Billing == order.Addresses[0])
+	assertEqual(t, true, order.Billing != addr)
+	assertEqual(t, "Chennai", order.Shipping.City)
+	assertEqual(t, true, order.Count == order.Total)
+	assertEqual(t, true, order.Count != &count)
+	assertEqual(t, 2, *order.Total)
+
+	dst := Order{}
+	errs := Copy(&dst, src, WithPreserveAliasing())
+	assertError(t, errors.Join(errs...))
+	assertEqual(t, true, dst.Billing == dst.Shipping)
+}
+
+func TestMultiLevelPointer(t *testing.T) {
+	type Inner struct {
+		Name string
+	}
+
+	type Sample struct {
+		Inner    **Inner
+		Count    ***int
+		Items    *[]*Inner
+		NilInner **Inner
+	}
+
+	in := &Inner{Name: "go-model"}
+	count := 5
+	pcount := &count
+	ppcount := &pcount
+	items := []*Inner{in}
+	var nilInner *Inner
+	src := Sample{Inner: &in, Count: &ppcount, Items: &items, NilInner: &nilInner}
+
+	dst := Sample{}
+	errs := Copy(&dst, src)
+	assertError(t, errors.Join(errs...))
+	assertEqual(t, "go-model", (*dst.Inner).Name)
+	assertEqual(t, true, *dst.Inner != in)
+	assertEqual(t, 5, ***dst.Count)
+	assertEqual(t, true, **dst.Count != pcount)
+	assertEqual(t, true, *dst.Count != ppcount)
+	assertEqual(t, "go-model", (*dst.Items)[0].Name)
+	assertEqual(t, true, (*dst.Items)[0] != in)
+	assertEqual(t, true, dst.NilInner != nil && *dst.NilInner == nil)
+
+	// mapped same as single level pointer
+	m, err := Map(src)
+	assertError(t, err)
+	assertEqual(t, map[string]interface{}{"Name": "go-model"}, m["Inner"])
+	assertEqual(t, 5, *(m["Count"].(*int)))
+	assertEqual(t, true, m["NilInner"].(*Inner) == nil)
+}
+
+type SampleEmbedBase struct {
+	ID   int
+	Note string
+}
+
+func TestEmbeddedPointer(t *testing.T) {
+	type Sample struct {
+		*SampleEmbedBase
+		Name string
+	}
+
+	type Flat struct {
+		ID   int
+		Name string
+	}
+
+	// embedded pointer is copied into new one
+	src := Sample{SampleEmbedBase: &SampleEmbedBase{ID: 1, Note: "note"}, Name: "go-model"}
+	dst := Sample{}
+	errs := Copy(&dst, src)
+	assertError(t, errors.Join(errs...))
+	assertEqual(t, 1, dst.ID)
+	assertEqual(t, true, dst.SampleEmbedBase != src.SampleEmbedBase)
+
+	// nil embedded pointer is allocated on demand
+	dst = Sample{}
+	errs = Copy(&dst, Flat{ID: 2, Name: "flat"})
+	assertError(t, errors.Join(errs...))
+	assertEqual(t, 2, dst.ID)
+	assertEqual(t, "flat", dst.Name)
+
+	dst = Sample{}
+	errs = Copy(&dst, Flat{Name: "flat"})
+	assertError(t, errors.Join(errs...))
+	assertEqual(t, true, dst.SampleEmbedBase == nil)
+
+	// flattened in map
+	m, err := Map(src)
+	assertError(t, err)
+	assertEqual(t, map[string]interface{}{"ID": 1, "Note": "note", "Name": "go-model"}, m)
+
+	m, err = Map(Sample{Name: "go-model"})
+	assertError(t, err)
+	assertEqual(t, map[string]interface{}{"ID": 0, "Note": "", "Name": "go-model"}, m)
+}
+
+func TestPointerValueAdaptation(t *testing.T) {
+	type Address struct {
+		City string
+	}
+
+	type Request struct {
+		Name    string
+		Age     *int
+		Email   string
+		Address Address
+		Home    *Address
+	}
+
+	type Response struct {
+		Name    *string
+		Age     int
+		Email   *string
+		Address *Address
+		Home    Address
+	}
+
+	age := 30
+	src := Request{Name: "go-model", Age: &age, Address: Address{City: "Chennai"}, Home: &Address{City: "Madurai"}}
+	dst := Response{}
+	errs := Copy(&dst, src)
+	assertError(t, errors.Join(errs...))
+	assertEqual(t, "go-model", *dst.Name)
+	assertEqual(t, 30, dst.Age)
+	assertEqual(t, true, dst.Email == nil)
+	assertEqual(t, "Chennai", dst.Address.City)
+	assertEqual(t, "Madurai", dst.Home.City)
+
+	// nil pointer is copied as zero value
+	back := Request{Name: "old", Age: &age}
+	errs = Copy(&back, Response{Name: dst.Name, Email: dst.Name})
+	assertError(t, errors.Join(errs...))
+	assertEqual(t, "go-model", back.Name)
+	assertEqual(t, "go-model", back.Email)
+	assertEqual(t, true, back.Age == nil)
+	assertEqual(t, true, back.Home == nil)
+
+	// new pointer is allocated, source is not shared
+	name := "shared"
+	dst = Response{}
+	errs = Copy(&dst, Request{Name: name})
+	assertError(t, errors.Join(errs...))
+	*dst.Name = "modified"
+	assertEqual(t, "shared", name)
+}
+
+func TestCopyIntoInterfaceIsDetached(t *testing.T) {
+	type Inner struct {
+		Name  string
+		Count *int
+	}
+
+	type Src struct {
+		Inner  *Inner
+		Items  map[string]*Inner
+		Value  interface{}
+		Values []int
+	}
+
+	type Dst struct {
+		Inner  interface{}
+		Items  interface{}
+		Value  interface{}
+		Values interface{}
+	}
+
+	count := 1
+	in := &Inner{Name: "go-model", Count: &count}
+	src := Src{Inner: in, Items: map[string]*Inner{"a": in}, Value: in, Values: []int{1}}
+
+	dst := Dst{}
+	errs := Copy(&dst, src)
+	assertError(t, errors.Join(errs...))
+
+	inner := dst.Inner.(*Inner)
+	assertEqual(t, "go-model", inner.Name)
+	assertEqual(t, true, inner != in)
+	assertEqual(t, true, inner.Count != &count)
+	assertEqual(t, true, dst.Items.(map[string]*Inner)["a"] != in)
+	assertEqual(t, true, dst.Value.(*Inner) != in)
+
+	dst.Values.([]int)[0] = 2
+	assertEqual(t, 1, src.Values[0])
+}
+
+func TestIsZeroMethodHonored(t *testing.T) {
+	type Sample struct {
+		Price     sampleMoney
+		ExpiresAt time.Time
+		Total     *sampleMoney
+	}
+
+	zeroInstant := time.Time{}.In(time.FixedZone("IST", 19800))
+	src := Sample{Price: sampleMoney{Currency: "INR"}, ExpiresAt: zeroInstant}
+	assertEqual(t, true, IsZero(src))
+	assertEqual(t, true, HasZero(Sample{Price: sampleMoney{Amount: 1}, ExpiresAt: time.Now(), Total: &sampleMoney{Currency: "INR"}}))
+	assertEqual(t, false, HasZero(Sample{Price: sampleMoney{Amount: 1}, ExpiresAt: time.Now(), Total: &sampleMoney{Amount: 1}}))
+
+	src.Total = &sampleMoney{Amount: 1, Currency: "INR"}
+	assertEqual(t, []string{"Price", "ExpiresAt"}, ZeroFields(src))
+
+	m, err := Map(Sample{Price: sampleMoney{Currency: "INR"}, ExpiresAt: zeroInstant})
+	assertError(t, err)
+	assertEqual(t, true, m["Price"] == sampleMoney{})
+}
+
+func TestEmptyAsZero(t *testing.T) {
+	type Sample struct {
+		Name   string
+		Tags   []string          `model:",omitempty"`
+		Labels map[string]string `model:",omitempty"`
+		Items  []int
+	}
+
+	src := Sample{Name: "go-model", Tags: []string{}, Labels: map[string]string{}, Items: []int{1}}
+	assertEqual(t, false, HasZero(src))
+	assertEqual(t, true, HasZero(src, WithEmptyAsZero()))
+	assertEqual(t, false, IsZero(Sample{Tags: []string{}}))
+	assertEqual(t, true, IsZero(Sample{Tags: []string{}}, WithEmptyAsZero()))
+
+	// by default empty collection overwrites the destination
+	dst := Sample{Tags: []string{"a"}, Labels: map[string]string{"k": "v"}}
+	errs := Copy(&dst, src)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, 0, len(dst.Tags))
+
+	dst = Sample{Tags: []string{"a"}, Labels: map[string]string{"k": "v"}}
+	errs = Copy(&dst, src, WithEmptyAsZero())
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, []string{"a"}, dst.Tags)
+	assertEqual(t, map[string]string{"k": "v"}, dst.Labels)
+
+	m, err := Map(src, WithEmptyAsZero())
+	assertError(t, err)
+	_, found := m["Tags"]
+	assertEqual(t, false, found)
+	_, found = m["Labels"]
+	assertEqual(t, false, found)
+
+	m, err = Map(src)
+	assertError(t, err)
+	_, found = m["Tags"]
+	assertEqual(t, true, found)
+}
+
+func TestNilAsEmpty(t *testing.T) {
+	type Item struct {
+		Tags []string
+	}
+
+	type Sample struct {
+		Name   string
+		Tags   []string
+		Labels map[string]int
+		Items  []Item
+		Skip   []string `model:",omitempty"`
+	}
+
+	src := Sample{Name: "go-model", Items: []Item{{}}}
+
+	dst := Sample{}
+	errs := Copy(&dst, src)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, true, dst.Tags == nil)
+
+	dst = Sample{}
+	errs = Copy(&dst, src, WithNilAsEmpty())
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, true, dst.Tags != nil && len(dst.Tags) == 0)
+	assertEqual(t, true, dst.Labels != nil && len(dst.Labels) == 0)
+	assertEqual(t, true, dst.Items[0].Tags != nil)
+	assertEqual(t, true, dst.Skip == nil)
+
+	m, err := Map(src, WithNilAsEmpty())
+	assertError(t, err)
+	b, _ := json.Marshal(m)
+	assertEqual(t, `{"Items":[{"Tags":[]}],"Labels":{},"Name":"go-model","Tags":[]}`, string(b))
+
+	m, err = Map(src)
+	assertError(t, err)
+	b, _ = json.Marshal(m)
+	assertEqual(t, `{"Items":[{"Tags":null}],"Labels":null,"Name":"go-model","Tags":null}`, string(b))
+}
+
+func TestCopyAppendSlice(t *testing.T) {
+	type Item struct {
+		Name string
+	}
+
+	type Page struct {
+		Items []*Item `model:",append"`
+		Tags  []string
+		Total int
+	}
+
+	result := Page{}
+	pages := []Page{
+		{Items: []*Item{{Name: "a"}, {Name: "b"}}, Tags: []string{"x"}, Total: 2},
+		{Items: []*Item{{Name: "c"}}, Tags: []string{"y"}, Total: 1},
+		{Tags: []string{"z"}},
+	}
+	for _, page := range pages {
+		errs := Copy(&result, page)
+		assertEqual(t, true, errs == nil)
+	}
+
+	assertEqual(t, 3, len(result.Items))
+	assertEqual(t, "c", result.Items[2].Name)
+	assertEqual(t, true, result.Items[0] != pages[0].Items[0])
+	assertEqual(t, []string{"z"}, result.Tags)
+
+	// option appends all the slice fields
+	type Result struct {
+		Items []*Item
+		Tags  []string
+	}
+
+	all := Result{Tags: []string{"w"}}
+	for _, page := range pages {
+		errs := Copy(&all, page, WithAppend())
+		assertEqual(t, true, errs == nil)
+	}
+	assertEqual(t, 3, len(all.Items))
+	assertEqual(t, []string{"w", "x", "y", "z"}, all.Tags)
+}
+
+func TestCopyMergeMap(t *testing.T) {
+	type Settings struct {
+		Labels map[string]string `model:",merge"`
+		Limits map[string]int
+	}
+
+	dst := Settings{Labels: map[string]string{"env": "dev", "team": "core"}, Limits: map[string]int{"cpu": 1}}
+	errs := Copy(&dst, Settings{Labels: map[string]string{"env": "prod", "region": "in"}, Limits: map[string]int{"mem": 2}})
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, map[string]string{"env": "prod", "team": "core", "region": "in"}, dst.Labels)
+	assertEqual(t, map[string]int{"mem": 2}, dst.Limits)
+
+	// nil source map keeps the destination
+	errs = Copy(&dst, Settings{Limits: map[string]int{"mem": 3}})
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, 3, len(dst.Labels))
+
+	// option merges all the map fields, nil destination gets the copy
+	type Overrides struct {
+		Labels map[string]string
+		Limits map[string]int
+	}
+	dst = Settings{Limits: map[string]int{"cpu": 1}}
+	errs = Copy(&dst, Overrides{Labels: map[string]string{"env": "qa"}, Limits: map[string]int{"cpu": 4, "mem": 2}}, WithMerge())
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, map[string]string{"env": "qa"}, dst.Labels)
+	assertEqual(t, map[string]int{"cpu": 4, "mem": 2}, dst.Limits)
+}
+
+func TestCopySkipNilPointers(t *testing.T) {
+	type Address struct {
+		City string
+	}
+
+	type User struct {
+		Name    *string
+		Email   string
+		Address *Address
+	}
+
+	type PatchRequest struct {
+		Name    *string
+		Email   *string
+		Address *Address
+	}
+
+	name, email := "jeeva", "jeeva@myjeeva.com"
+	user := User{Name: &name, Email: email, Address: &Address{City: "Chennai"}}
+
+	newEmail := "new@myjeeva.com"
+	errs := Copy(&user, PatchRequest{Email: &newEmail}, WithSkipNilPointers())
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "jeeva", *user.Name)
+	assertEqual(t, "new@myjeeva.com", user.Email)
+	assertEqual(t, "Chennai", user.Address.City)
+
+	// by default nil pointer sets destination to zero value
+	errs = Copy(&user, PatchRequest{Email: &newEmail})
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, true, user.Name == nil)
+	assertEqual(t, true, user.Address == nil)
+}
+
+func TestCopyFailFast(t *testing.T) {
+	type Address struct {
+		City string
+		Zip  string
+	}
+
+	type Src struct {
+		Name    string
+		Count   string
+		Address Address
+		Status  string
+	}
+
+	type DstAddress struct {
+		City int
+		Zip  int
+	}
+
+	type Dst struct {
+		Name    string
+		Count   int
+		Address DstAddress
+		Status  int
+	}
+
+	src := Src{Name: "go-model", Count: "1", Address: Address{City: "Chennai", Zip: "600001"}, Status: "active"}
+
+	// by default all the errors are collected
+	var dst Dst
+	errs := Copy(&dst, src)
+	assertEqual(t, 3, len(errs))
+
+	dst = Dst{}
+	errs = Copy(&dst, src, WithFailFast())
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, "Field: 'Count', src [string] & dst [int] kind didn't match", errs[0].Error())
+	assertEqual(t, "go-model", dst.Name)
+}
+
+func TestCopyMaxErrors(t *testing.T) {
+	type Src struct {
+		A, B, C, D string
+	}
+
+	type Dst struct {
+		A, B, C, D int
+	}
+
+	src := Src{A: "a", B: "b", C: "c", D: "d"}
+
+	var dst Dst
+	errs := Copy(&dst, src, WithMaxErrors(2))
+	assertEqual(t, 3, len(errs))
+	assertEqual(t, "Field: 'A', src [string] & dst [int] kind didn't match", errs[0].Error())
+	assertEqual(t, "+1 more errors", errs[2].Error())
+
+	// copy is stopped beyond the limit
+	more, ok := errs[2].(MoreErrors)
+	assertEqual(t, true, ok)
+	assertEqual(t, 1, int(more))
+
+	items := make([]Src, 1000)
+	for i := range items {
+		items[i] = src
+	}
+
+	var dsts []Dst
+	errs = CopySlice(&dsts, items, WithMaxErrors(5))
+	assertEqual(t, 6, len(errs))
+	assertEqual(t, "Field: '[1].A', src [string] & dst [int] kind didn't match", errs[4].Error())
+	assertEqual(t, MoreErrors(3), errs[5])
+
+	// within limit
+	errs = Copy(&dst, src, WithMaxErrors(4))
+	assertEqual(t, 4, len(errs))
+
+	// no limit
+	errs = Copy(&dst, src, WithMaxErrors(0))
+	assertEqual(t, 4, len(errs))
+}
+
+func TestCopySkipFunc(t *testing.T) {
+	type Address struct {
+		City    string
+		Private string `access:"internal"`
+	}
+
+	type User struct {
+		Name    string
+		Secret  string `access:"internal"`
+		Note    string
+		Address Address
+	}
+
+	internal := SkipFunc(func(f reflect.StructField, v reflect.Value) bool {
+		return f.Tag.Get("access") == "internal"
+	})
+
+	src := User{Name: "jeeva", Secret: "s3cr3t", Address: Address{City: "Chennai", Private: "p"}}
+
+	dst := User{Secret: "existing", Note: "keep"}
+	errs := Copy(&dst, src, internal)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "jeeva", dst.Name)
+	assertEqual(t, "existing", dst.Secret)
+	assertEqual(t, "", dst.Note)
+	assertEqual(t, "Chennai", dst.Address.City)
+	assertEqual(t, "", dst.Address.Private)
+
+	// by emptiness
+	dst = User{Note: "keep"}
+	errs = Copy(&dst, src, SkipFunc(func(f reflect.StructField, v reflect.Value) bool {
+		return v.Kind() == reflect.String && v.Len() == 0
+	}))
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "keep", dst.Note)
+	assertEqual(t, "s3cr3t", dst.Secret)
+
+	m, err := Map(src, internal)
+	assertError(t, err)
+	_, found := m["Secret"]
+	assertEqual(t, false, found)
+	_, found = m["Address"].(map[string]interface{})["Private"]
+	assertEqual(t, false, found)
+	assertEqual(t, "jeeva", m["Name"])
+}
+
+func TestMapJSONTags(t *testing.T) {
+	type Vendor struct {
+		Name    string `json:"vendor_name"`
+		Country string `json:"country,omitempty"`
+	}
+
+	type Product struct {
+		Name        string `json:"name"`
+		Description string `json:"desc,omitempty"`
+		Secret      string `json:"-"`
+		SKU         string `json:"sku" model:"productSku"`
+		Stock       int
+		Vendor      Vendor `json:"vendor"`
+	}
+
+	p := Product{Name: "go-model", Secret: "s3cr3t", SKU: "GM-01", Stock: 5, Vendor: Vendor{Name: "jeeva"}}
+
+	m, err := Map(p, WithJSONTags())
+	assertError(t, err)
+	assertEqual(t, 4, len(m))
+	assertEqual(t, "go-model", m["name"])
+	assertEqual(t, "GM-01", m["productSku"])
+	assertEqual(t, 5, m["Stock"])
+	assertEqual(t, map[string]interface{}{"vendor_name": "jeeva"}, m["vendor"])
+
+	// model tag only by default
+	m, err = Map(p)
+	assertError(t, err)
+	assertEqual(t, 6, len(m))
+	assertEqual(t, "s3cr3t", m["Secret"])
+}
+
+func TestWithTagName(t *testing.T) {
+	type Vendor struct {
+		Name string `mapper:"vendorName"`
+		Code string `mapper:",omitempty"`
+	}
+
+	type Product struct {
+		Name   string `mapper:"productName" model:"name"`
+		Secret string `mapper:"-"`
+		Stock  int    `model:"-"`
+		Vendor Vendor
+	}
+
+	p := Product{Name: "go-model", Secret: "s3cr3t", Stock: 5, Vendor: Vendor{Name: "jeeva"}}
+
+	m, err := Map(p, WithTagName("mapper"))
+	assertError(t, err)
+	assertEqual(t, 3, len(m))
+	assertEqual(t, "go-model", m["productName"])
+	assertEqual(t, 5, m["Stock"])
+	assertEqual(t, map[string]interface{}{"vendorName": "jeeva"}, m["Vendor"])
+
+	// default tag is not affected
+	m, err = Map(p)
+	assertError(t, err)
+	assertEqual(t, "go-model", m["name"])
+	assertEqual(t, "s3cr3t", m["Secret"])
+
+	dst := Product{Secret: "existing"}
+	errs := Copy(&dst, p, WithTagName("mapper"))
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "go-model", dst.Name)
+	assertEqual(t, "existing", dst.Secret)
+	assertEqual(t, 5, dst.Stock)
+
+	dst = Product{Secret: "existing", Stock: 1}
+	errs = Copy(&dst, p)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "s3cr3t", dst.Secret)
+	assertEqual(t, 1, dst.Stock)
+
+	// compiled plan
+	plan, err := CompilePlan(Product{}, Product{})
+	assertError(t, err)
+
+	dst = Product{Secret: "existing"}
+	errs = plan.Copy(&dst, p, WithTagName("mapper"))
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "existing", dst.Secret)
+
+	var fp Product
+	errs = FromMap(&fp, map[string]interface{}{"productName": "go-model", "Secret": "s3cr3t"}, WithTagName("mapper"))
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "go-model", fp.Name)
+	assertEqual(t, "", fp.Secret)
+
+	// zero check ignores the omitted field
+	assertEqual(t, true, IsZero(Product{Secret: "s3cr3t"}, WithTagName("mapper")))
+	assertEqual(t, false, IsZero(Product{Secret: "s3cr3t"}))
+}
+
+func TestWithTagNameOfStringMapAndPath(t *testing.T) {
+	type Product struct {
+		Name   string `mapper:"productName" model:"name"`
+		Secret string `mapper:"-"`
+		Stock  int    `mapper:"stock"`
+	}
+
+	var p Product
+	errs := FromStringMap(&p, map[string]string{"productName": "go-model", "Secret": "s3cr3t", "stock": "5"},
+		WithTagName("mapper"))
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "go-model", p.Name)
+	assertEqual(t, "", p.Secret)
+	assertEqual(t, 5, p.Stock)
+
+	// default tag is not affected
+	p = Product{}
+	errs = FromStringMap(&p, map[string]string{"name": "go-model", "Secret": "s3cr3t"})
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "go-model", p.Name)
+	assertEqual(t, "s3cr3t", p.Secret)
+
+	// flatten and unflatten round trip with custom tag
+	m, err := Flatten(Product{Name: "go-model", Secret: "s3cr3t", Stock: 5}, WithTagName("mapper"))
+	assertError(t, err)
+	assertEqual(t, map[string]interface{}{"productName": "go-model", "stock": 5}, m)
+
+	p = Product{}
+	errs = Unflatten(&p, m, WithTagName("mapper"))
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "go-model", p.Name)
+	assertEqual(t, 5, p.Stock)
+
+	errs = Unflatten(&p, map[string]interface{}{"Secret": "s3cr3t"}, WithTagName("mapper"))
+	assertEqual(t, "Field: 'Secret', does not exists", errs[0].Error())
+
+	// path methods use the field name regardless of tag
+	err = Set(&p, "Secret", "s3cr3t")
+	assertError(t, err)
+	assertEqual(t, "s3cr3t", p.Secret)
+
+	v, err := Get(p, "Name")
+	assertError(t, err)
+	assertEqual(t, "go-model", v)
+
+	_, err = Get(p, "productName")
+	assertEqual(t, true, errors.Is(err, ErrFieldNotFound))
+}
+
+func TestMapInline(t *testing.T) {
+	type Address struct {
+		City string `model:"city"`
+		Zip  string `model:"zip,omitempty"`
+	}
+
+	type Audit struct {
+		CreatedBy string
+	}
+
+	type User struct {
+		Name    string  `model:"name"`
+		Address Address `model:",inline"`
+		Audit   *Audit  `model:",squash"`
+		Billing Address `model:"billing"`
+	}
+
+	u := User{Name: "jeeva", Address: Address{City: "Chennai"}, Audit: &Audit{CreatedBy: "admin"}, Billing: Address{City: "Madurai"}}
+
+	m, err := Map(u)
+	assertError(t, err)
+	assertEqual(t, 4, len(m))
+	assertEqual(t, "jeeva", m["name"])
+	assertEqual(t, "Chennai", m["city"])
+	assertEqual(t, "admin", m["CreatedBy"])
+	assertEqual(t, map[string]interface{}{"city": "Madurai"}, m["billing"])
+
+	// nil pointer is hoisted with zero values
+	m, err = Map(User{Name: "jeeva"})
+	assertError(t, err)
+	assertEqual(t, "", m["CreatedBy"])
+
+	var fu User
+	errs := FromMap(&fu, map[string]interface{}{"name": "jeeva", "city": "Chennai", "CreatedBy": "admin"})
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "Chennai", fu.Address.City)
+	assertEqual(t, "admin", fu.Audit.CreatedBy)
+}
+
+func TestMapNilPointers(t *testing.T) {
+	type Address struct {
+		City string
+	}
+
+	type User struct {
+		Name    *string
+		Age     *int
+		Address *Address
+		Note    *string `model:",omitempty"`
+		Tags    map[string]*Address
+	}
+
+	name := "jeeva"
+	u := User{Name: &name, Tags: map[string]*Address{"home": nil}}
+
+	// default is zero value of pointer type
+	m, err := Map(u)
+	assertError(t, err)
+	assertEqual(t, 4, len(m))
+	assertEqual(t, false, m["Age"] == nil)
+	assertEqual(t, true, m["Age"].(*int) == nil)
+	assertEqual(t, true, m["Tags"].(map[string]interface{})["home"].(*Address) == nil)
+
+	m, err = Map(u, WithNilPointers(NilPointerNil))
+	assertError(t, err)
+	assertEqual(t, 4, len(m))
+	assertEqual(t, "jeeva", *(m["Name"].(*string)))
+	assertEqual(t, true, m["Age"] == nil)
+	assertEqual(t, true, m["Address"] == nil)
+
+	b, err := json.Marshal(m)
+	assertError(t, err)
+	assertEqual(t, `{"Address":null,"Age":null,"Name":"jeeva","Tags":{"home":null}}`, string(b))
+
+	m, err = Map(u, WithNilPointers(NilPointerOmit))
+	assertError(t, err)
+	assertEqual(t, 2, len(m))
+	_, found := m["Age"]
+	assertEqual(t, false, found)
+}
+
+func TestMapDerefPointers(t *testing.T) {
+	type Address struct {
+		City *string
+	}
+
+	type User struct {
+		Name      *string
+		Age       *int
+		Score     **int
+		Address   *Address
+		Addresses []*Address
+		Labels    map[string]*string
+	}
+
+	name, city, score := "jeeva", "Chennai", 10
+	pscore := &score
+	u := User{
+		Name:      &name,
+		Score:     &pscore,
+		Address:   &Address{City: &city},
+		Addresses: []*Address{{City: &city}},
+		Labels:    map[string]*string{"city": &city, "none": nil},
+	}
+
+	m, err := Map(u, WithDerefPointers())
+	assertError(t, err)
+	assertEqual(t, "jeeva", m["Name"])
+	assertEqual(t, 0, m["Age"])
+	assertEqual(t, 10, m["Score"])
+	assertEqual(t, map[string]interface{}{"City": "Chennai"}, m["Address"])
+	assertEqual(t, []interface{}{map[string]interface{}{"City": "Chennai"}}, m["Addresses"])
+	assertEqual(t, map[string]interface{}{"city": "Chennai", "none": ""}, m["Labels"])
+
+	// nil pointer mode takes precedence
+	m, err = Map(u, WithDerefPointers(), WithNilPointers(NilPointerNil))
+	assertError(t, err)
+	assertEqual(t, "jeeva", m["Name"])
+	assertEqual(t, true, m["Age"] == nil)
+
+	// pointers are kept by default
+	m, err = Map(u)
+	assertError(t, err)
+	assertEqual(t, "jeeva", *(m["Name"].(*string)))
+}
+
+func TestMapTypedKeys(t *testing.T) {
+	type Point struct {
+		X, Y int
+	}
+
+	type Sample struct {
+		Counts  map[int]int
+		Points  map[Point]string
+		Names   map[string]int
+		Nested  map[int]map[int]bool
+		Empty   map[int]int
+		Squares *map[int]int
+	}
+
+	squares := map[int]int{3: 9}
+	src := Sample{
+		Counts:  map[int]int{2: 4},
+		Points:  map[Point]string{{1, 2}: "a"},
+		Names:   map[string]int{"one": 1},
+		Nested:  map[int]map[int]bool{1: {2: true}},
+		Empty:   map[int]int{},
+		Squares: &squares,
+	}
+
+	m, err := Map(src, WithTypedMapKeys())
+	assertError(t, err)
+	assertEqual(t, map[interface{}]interface{}{2: 4}, m["Counts"])
+	assertEqual(t, map[interface{}]interface{}{Point{1, 2}: "a"}, m["Points"])
+	assertEqual(t, map[string]interface{}{"one": 1}, m["Names"])
+	assertEqual(t, map[interface{}]interface{}{1: map[interface{}]interface{}{2: true}}, m["Nested"])
+	assertEqual(t, map[interface{}]interface{}{}, m["Empty"])
+
+	squaresMap := m["Squares"].(*map[interface{}]interface{})
+	assertEqual(t, map[interface{}]interface{}{3: 9}, *squaresMap)
+
+	// keys are stringified by default
+	m, err = Map(src)
+	assertError(t, err)
+	assertEqual(t, map[string]interface{}{"2": 4}, m["Counts"])
+}
+
+func TestMapShallow(t *testing.T) {
+	type Address struct {
+		City string
+	}
+
+	type Audit struct {
+		CreatedBy string
+	}
+
+	type User struct {
+		Audit
+		Name      string
+		Address   Address
+		Home      *Address
+		Work      *Address
+		Tags      []string
+		Scores    map[int]int
+		Secret    string `model:",redact"`
+		Nickname  string `model:",omitempty"`
+		Ignored   string `model:"-"`
+		Formatted string `model:"label"`
+	}
+
+	home := &Address{City: "Chennai"}
+	src := User{
+		Audit:     Audit{CreatedBy: "admin"},
+		Name:      "jeeva",
+		Address:   Address{City: "Chennai"},
+		Home:      home,
+		Tags:      []string{"go"},
+		Scores:    map[int]int{1: 10},
+		Secret:    "s3cr3t",
+		Formatted: "value",
+	}
+
+	m, err := MapShallow(src, WithRedact(RedactMask))
+	assertError(t, err)
+	assertEqual(t, "admin", m["CreatedBy"])
+	assertEqual(t, "jeeva", m["Name"])
+	assertEqual(t, true, m["Address"].(Address) == src.Address)
+	assertEqual(t, true, m["Home"].(*Address) == home)
+	assertEqual(t, true, m["Work"].(*Address) == nil)
+	assertEqual(t, []string{"go"}, m["Tags"])
+	assertEqual(t, map[int]int{1: 10}, m["Scores"])
+	assertEqual(t, RedactMaskValue, m["Secret"])
+	assertEqual(t, "value", m["label"])
+
+	_, found := m["Nickname"]
+	assertEqual(t, false, found)
+
+	_, found = m["Ignored"]
+	assertEqual(t, false, found)
+
+	_, err = MapShallow(nil)
+	assertEqual(t, true, errors.Is(err, ErrNilInput))
+}
+
+func TestWithMaxDepth(t *testing.T) {
+	type Geo struct {
+		Lat, Lng float64
+	}
+
+	type Address struct {
+		City string
+		Geo  Geo
+	}
+
+	type Audit struct {
+		CreatedBy string
+		Origin    Address
+	}
+
+	type User struct {
+		Audit
+		Name     string
+		Address  Address
+		Previous []Address
+	}
+
+	src := User{
+		Audit:    Audit{CreatedBy: "admin", Origin: Address{City: "Madurai"}},
+		Name:     "jeeva",
+		Address:  Address{City: "Chennai", Geo: Geo{13.08, 80.27}},
+		Previous: []Address{{City: "Madurai", Geo: Geo{9.92, 78.11}}},
+	}
+
+	// map
+	m, err := Map(src, WithMaxDepth(1))
+	assertError(t, err)
+	assertEqual(t, "admin", m["CreatedBy"])
+	assertEqual(t, true, m["Address"].(Address) == src.Address)
+	assertEqual(t, true, m["Origin"].(Address) == src.Origin)
+	assertEqual(t, true, m["Previous"].([]Address)[0] == src.Previous[0])
+
+	m, err = Map(src, WithMaxDepth(2))
+	assertError(t, err)
+	address := m["Address"].(map[string]interface{})
+	assertEqual(t, "Chennai", address["City"])
+	assertEqual(t, true, address["Geo"].(Geo) == src.Address.Geo)
+	previous := m["Previous"].([]interface{})[0].(map[string]interface{})
+	assertEqual(t, true, previous["Geo"].(Geo) == src.Previous[0].Geo)
+
+	m, err = Map(src)
+	assertError(t, err)
+	address = m["Address"].(map[string]interface{})
+	assertEqual(t, map[string]interface{}{"Lat": 13.08, "Lng": 80.27}, address["Geo"])
+
+	// copy
+	var (
+		dst    User
+		report CopyReport
+	)
+	errs := Copy(&dst, src, WithMaxDepth(1))
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, true, dst.Address == src.Address)
+	assertEqual(t, true, dst.Origin == src.Origin)
+	assertEqual(t, true, dst.Previous[0] == src.Previous[0])
+
+	// nested struct is traversed within the depth, deeper struct is
+	// shared as-is
+	dst = User{}
+	errs = Copy(&dst, &src, WithMaxDepth(2), WithReport(&report))
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, true, dst.Address == src.Address)
+	assertEqual(t, true, dst.Previous[0] == src.Previous[0])
+	assertEqual(t, []string{"CreatedBy", "Origin.City", "Origin.Geo", "Name", "Address.City", "Address.Geo", "Previous[0].City", "Previous[0].Geo", "Previous"}, report.Copied)
+}
+
+func TestMapOnlyExcept(t *testing.T) {
+	type Address struct {
+		City string `model:"city"`
+		Zip  string `model:"zip"`
+	}
+
+	type Audit struct {
+		CreatedBy string
+	}
+
+	type User struct {
+		Audit
+		Name      string
+		Email     string `model:"email"`
+		Password  string
+		Address   Address
+		Addresses []Address
+	}
+
+	src := User{
+		Audit:     Audit{CreatedBy: "admin"},
+		Name:      "jeeva",
+		Email:     "jeeva@myjeeva.com",
+		Password:  "s3cr3t",
+		Address:   Address{City: "Chennai", Zip: "600001"},
+		Addresses: []Address{{City: "Madurai", Zip: "625001"}},
+	}
+
+	m, err := Map(src, Only("Name", "email"))
+	assertError(t, err)
+	assertEqual(t, map[string]interface{}{"Name": "jeeva", "email": "jeeva@myjeeva.com"}, m)
+
+	m, err = Map(src, Except("Password", "CreatedBy", "Address.zip", "Addresses.*.Zip"))
+	assertError(t, err)
+	assertEqual(t, map[string]interface{}{
+		"Name":      "jeeva",
+		"email":     "jeeva@myjeeva.com",
+		"Address":   map[string]interface{}{"city": "Chennai"},
+		"Addresses": []interface{}{map[string]interface{}{"city": "Madurai"}},
+	}, m)
+
+	m, err = Map(src, Only("Name", "Address"), Except("Address.City"))
+	assertError(t, err)
+	assertEqual(t, map[string]interface{}{
+		"Name":    "jeeva",
+		"Address": map[string]interface{}{"zip": "600001"},
+	}, m)
+}
+
 //
 // helper test methods
 //
@@ -0,0 +1,104 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import "reflect"
+
+// noTraverseProfiles keeps track of named no-traverse type sets at library level
+var noTraverseProfiles = map[string]map[reflect.Type]bool{}
+
+// AddNoTraverseProfile method adds the Go Lang type(s) into named no-traverse
+// profile, profile gets created if not exists. The profile is selected per call
+// via `WithNoTraverseProfile()` option, so the different subsystems can have
+// different traversal boundaries for the same types. See also `RemoveNoTraverseProfile()`.
+// 		model.AddNoTraverseProfile("http", url.URL{}, &url.URL{})
+// 		model.AddNoTraverseProfile("db", sql.NullString{}, sql.NullInt64{})
+//
+func AddNoTraverseProfile(name string, i ...interface{}) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	profile, found := noTraverseProfiles[name]
+	if !found {
+		profile = map[reflect.Type]bool{}
+		noTraverseProfiles[name] = profile
+	}
+
+	for _, v := range i {
+		profile[reflect.TypeOf(v)] = true
+	}
+}
+
+// RemoveNoTraverseProfile method removes the named no-traverse profile.
+func RemoveNoTraverseProfile(name string) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	delete(noTraverseProfiles, name)
+}
+
+// WithNoTraverseProfile option selects the named no-traverse profile(s) for the
+// call, types from the profile are considered as "No Traverse" type in addition
+// to `NoTraverseTypeList`. Profile which is not registered is ignored.
+// 		Example:
+//
+// 		errs := model.Copy(&dst, src, model.WithNoTraverseProfile("http"))
+//
+func WithNoTraverseProfile(names ...string) Option {
+	return func(o *options) {
+		registryMu.RLock()
+		defer registryMu.RUnlock()
+
+		for _, name := range names {
+			for t := range noTraverseProfiles[name] {
+				if o.noTraverse == nil {
+					o.noTraverse = map[reflect.Type]bool{}
+				}
+				o.noTraverse[t] = true
+			}
+		}
+	}
+}
+
+// WithNoTraverseType option adds the Go Lang type(s) as "No Traverse" type for
+// the call only, in addition to `NoTraverseTypeList`. So caller specific type
+// (for eg.: proprietary decimal type) doesn't affect every caller in the binary.
+// 		Example:
+//
+// 		errs := model.Copy(&dst, src, model.WithNoTraverseType(decimal.Decimal{}, &decimal.Decimal{}))
+//
+// 		// reusable for many calls, for eg.: with compiled `Plan`
+// 		noTraverse := model.WithNoTraverseType(decimal.Decimal{}, &decimal.Decimal{})
+// 		errs = plan.Copy(&dst, src, noTraverse)
+//
+func WithNoTraverseType(i ...interface{}) Option {
+	types := make([]reflect.Type, 0, len(i))
+	for _, v := range i {
+		types = append(types, reflect.TypeOf(v))
+	}
+
+	return func(o *options) {
+		for _, t := range types {
+			if o.noTraverse == nil {
+				o.noTraverse = map[reflect.Type]bool{}
+			}
+			o.noTraverse[t] = true
+		}
+	}
+}
+
+// isNoTraverseType method reports the value type is in `NoTraverseTypeList`
+// or no-traverse types of the call.
+func (o *options) isNoTraverseType(v reflect.Value) bool {
+	if isNoTraverseType(v) {
+		return true
+	}
+
+	if len(o.noTraverse) == 0 || !isStruct(v) {
+		return false
+	}
+
+	return o.noTraverse[deepTypeOf(v)]
+}
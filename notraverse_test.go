@@ -0,0 +1,145 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import "testing"
+
+func TestNoTraverseProfile(t *testing.T) {
+	type Header struct {
+		Value *string
+	}
+
+	type SampleStruct struct {
+		Name    string
+		Header  Header
+		Headers []Header
+	}
+
+	AddNoTraverseProfile("http", Header{})
+	defer RemoveNoTraverseProfile("http")
+
+	value := "go-model"
+	src := SampleStruct{
+		Name:    "go-model",
+		Header:  Header{Value: &value},
+		Headers: []Header{{Value: &value}},
+	}
+
+	// default, traversed and deep copied
+	dst := SampleStruct{}
+	errs := Copy(&dst, src)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, false, dst.Header.Value == src.Header.Value)
+	assertEqual(t, false, dst.Headers[0].Value == src.Headers[0].Value)
+
+	// profile, not traversed
+	dst = SampleStruct{}
+	errs = Copy(&dst, src, WithNoTraverseProfile("http"))
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, true, dst.Header.Value == src.Header.Value)
+	assertEqual(t, true, dst.Headers[0].Value == src.Headers[0].Value)
+
+	result, err := Map(src, WithNoTraverseProfile("http"))
+	assertError(t, err)
+	_, ok := result["Header"].(Header)
+	assertEqual(t, true, ok)
+
+	result, err = Map(src, WithNoTraverseProfile("db"))
+	assertError(t, err)
+	_, ok = result["Header"].(map[string]interface{})
+	assertEqual(t, true, ok)
+
+	// profile is switchable at runtime
+	RemoveNoTraverseProfile("http")
+	dst = SampleStruct{}
+	errs = Copy(&dst, src, WithNoTraverseProfile("http"))
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, false, dst.Header.Value == src.Header.Value)
+}
+
+func TestWithNoTraverseType(t *testing.T) {
+	type Decimal struct {
+		Digits *string
+	}
+
+	type SampleStruct struct {
+		Amount  Decimal
+		Amounts []*Decimal
+	}
+
+	digits := "1050"
+	src := SampleStruct{
+		Amount:  Decimal{Digits: &digits},
+		Amounts: []*Decimal{{Digits: &digits}},
+	}
+	noTraverse := WithNoTraverseType(Decimal{}, &Decimal{})
+
+	dst := SampleStruct{}
+	errs := Copy(&dst, src, noTraverse)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, true, dst.Amount.Digits == src.Amount.Digits)
+	assertEqual(t, true, dst.Amounts[0].Digits == src.Amounts[0].Digits)
+
+	// option is reusable
+	plan, err := CompilePlan(src, dst)
+	assertError(t, err)
+	dst = SampleStruct{}
+	errs = plan.Copy(&dst, src, noTraverse)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, true, dst.Amount.Digits == src.Amount.Digits)
+
+	result, err := Clone(src, noTraverse)
+	assertError(t, err)
+	assertEqual(t, true, result.(*SampleStruct).Amount.Digits == src.Amount.Digits)
+
+	m, err := Map(src, noTraverse)
+	assertError(t, err)
+	_, ok := m["Amount"].(Decimal)
+	assertEqual(t, true, ok)
+
+	// global list is not modified
+	dst = SampleStruct{}
+	errs = Copy(&dst, src)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, false, dst.Amount.Digits == src.Amount.Digits)
+	assertEqual(t, false, dst.Amounts[0].Digits == src.Amounts[0].Digits)
+
+	result, err = Clone(src)
+	assertError(t, err)
+	assertEqual(t, false, result.(*SampleStruct).Amount.Digits == src.Amount.Digits)
+}
+
+func TestFromMapWithNoTraverseType(t *testing.T) {
+	type Decimal struct {
+		Digits string
+	}
+
+	type SampleStruct struct {
+		Amount  Decimal
+		Amounts []Decimal
+	}
+
+	m := map[string]interface{}{
+		"Amount":  map[string]interface{}{"Digits": "1050"},
+		"Amounts": []interface{}{map[string]interface{}{"Digits": "2050"}},
+	}
+
+	dst := SampleStruct{}
+	errs := FromMap(&dst, m)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "1050", dst.Amount.Digits)
+	assertEqual(t, "2050", dst.Amounts[0].Digits)
+
+	// no-traverse struct is assigned as-is, so nested map is not populated
+	dst = SampleStruct{}
+	errs = FromMap(&dst, m, WithNoTraverseType(Decimal{}))
+	assertEqual(t, 2, len(errs))
+	assertEqual(t, "Field: 'Amount', type [map[string]interface {}] is not assignable to [model.Decimal]", errs[0].Error())
+
+	// value of no-traverse type is assigned
+	errs = FromMap(&dst, map[string]interface{}{"Amount": Decimal{Digits: "1050"}}, WithNoTraverseType(Decimal{}))
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "1050", dst.Amount.Digits)
+}
@@ -0,0 +1,114 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+)
+
+// WithNumericConvert option converts the number between all integer and float
+// widths, for eg.: `int32` to `int64`, `int` to `int8`, `float64` to `float32`.
+// Value which overflows the destination type or loses precision (fraction of float
+// into integer, large integer into float) is reported as an error and the
+// destination field is left untouched. Slice and map elements are converted too.
+// 		Example:
+//
+// 		errs := model.Copy(&dst, src, model.WithNumericConvert())
+//
+// 		// Output:
+// 		value [300] of [int] overflows [int8]
+//
+// Note: Float into narrower float is checked for overflow only, since most of
+// the decimal fractions are not exactly representable in any width.
+//
+func WithNumericConvert() Option {
+	return func(o *options) {
+		o.numericConvert = true
+	}
+}
+
+// convertNumber method converts the number value into destination number type
+// with overflow and precision loss detection.
+func convertNumber(v reflect.Value, dt reflect.Type) (reflect.Value, error) {
+	nv := reflect.New(dt).Elem()
+
+	var overflow, lossy bool
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i := v.Int()
+		switch {
+		case isSignedKind(dt.Kind()):
+			overflow = nv.OverflowInt(i)
+			nv.SetInt(i)
+		case isIntegerKind(dt.Kind()):
+			overflow = i < 0 || nv.OverflowUint(uint64(i))
+			nv.SetUint(uint64(i))
+		default:
+			f, accuracy := new(big.Float).SetInt64(i).Float64()
+			lossy = accuracy != big.Exact
+			nv.SetFloat(f)
+			lossy = lossy || (dt.Kind() == reflect.Float32 && float64(float32(f)) != f)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u := v.Uint()
+		switch {
+		case isSignedKind(dt.Kind()):
+			overflow = u > math.MaxInt64 || nv.OverflowInt(int64(u))
+			nv.SetInt(int64(u))
+		case isIntegerKind(dt.Kind()):
+			overflow = nv.OverflowUint(u)
+			nv.SetUint(u)
+		default:
+			f, accuracy := new(big.Float).SetUint64(u).Float64()
+			lossy = accuracy != big.Exact
+			nv.SetFloat(f)
+			lossy = lossy || (dt.Kind() == reflect.Float32 && float64(float32(f)) != f)
+		}
+	default:
+		f := v.Float()
+		switch {
+		case isSignedKind(dt.Kind()):
+			lossy = f != math.Trunc(f)
+			overflow = math.IsInf(f, 0) || f < math.MinInt64 || f >= math.MaxInt64 || nv.OverflowInt(int64(f))
+			if !overflow && !lossy {
+				nv.SetInt(int64(f))
+			}
+		case isIntegerKind(dt.Kind()):
+			lossy = f != math.Trunc(f)
+			overflow = math.IsInf(f, 0) || f < 0 || f >= math.MaxUint64 || nv.OverflowUint(uint64(f))
+			if !overflow && !lossy {
+				nv.SetUint(uint64(f))
+			}
+		default:
+			overflow = !math.IsInf(f, 0) && nv.OverflowFloat(f)
+			nv.SetFloat(f)
+		}
+	}
+
+	if overflow {
+		return reflect.Value{}, newError(ErrTypeMismatch, "value [%v] of [%v] overflows [%v]", v, v.Type(), dt)
+	}
+
+	if lossy {
+		return reflect.Value{}, newError(ErrTypeMismatch, "value [%v] of [%v] loses precision in [%v]", v, v.Type(), dt)
+	}
+
+	return nv, nil
+}
+
+func isNumberKind(k reflect.Kind) bool {
+	return isIntegerKind(k) || k == reflect.Float32 || k == reflect.Float64
+}
+
+func isSignedKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+
+	return false
+}
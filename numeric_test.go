@@ -0,0 +1,102 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestCopyWithNumericConvert(t *testing.T) {
+	type Source struct {
+		Count    int32
+		Small    int
+		Ratio    float64
+		Unsigned int64
+		Whole    float64
+		Sizes    []int
+		Limits   map[string]uint8
+	}
+
+	type Destination struct {
+		Count    int64
+		Small    int8
+		Ratio    float32
+		Unsigned uint16
+		Whole    int
+		Sizes    []int16
+		Limits   map[string]float64
+	}
+
+	src := Source{
+		Count:    10,
+		Small:    100,
+		Ratio:    0.5,
+		Unsigned: 65535,
+		Whole:    42,
+		Sizes:    []int{1, 2},
+		Limits:   map[string]uint8{"daily": 255},
+	}
+
+	dst := Destination{}
+	errs := Copy(&dst, src, WithNumericConvert())
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, int64(10), dst.Count)
+	assertEqual(t, int8(100), dst.Small)
+	assertEqual(t, float32(0.5), dst.Ratio)
+	assertEqual(t, uint16(65535), dst.Unsigned)
+	assertEqual(t, 42, dst.Whole)
+	assertEqual(t, []int16{1, 2}, dst.Sizes)
+	assertEqual(t, map[string]float64{"daily": 255}, dst.Limits)
+
+	// overflow and precision loss
+	src = Source{Count: 1, Small: 300, Ratio: math.MaxFloat64, Unsigned: -1, Whole: 4.2, Sizes: []int{1, 40000}}
+	dst = Destination{Small: 7}
+	errs = Copy(&dst, src, WithNumericConvert())
+	assertEqual(t, 5, len(errs))
+	assertEqual(t, "Field: 'Small', value [300] of [int] overflows [int8]", errs[0].Error())
+	assertEqual(t, "Field: 'Ratio', value [1.7976931348623157e+308] of [float64] overflows [float32]", errs[1].Error())
+	assertEqual(t, "Field: 'Unsigned', value [-1] of [int64] overflows [uint16]", errs[2].Error())
+	assertEqual(t, "Field: 'Whole', value [4.2] of [float64] loses precision in [int]", errs[3].Error())
+	assertEqual(t, "Field: 'Sizes[1]', value [40000] of [int] overflows [int16]", errs[4].Error())
+	assertEqual(t, int64(1), dst.Count)
+	assertEqual(t, int8(7), dst.Small)
+
+	// without option
+	errs = Copy(&Destination{}, Source{Count: 10})
+	assertEqual(t, "Field: 'Count', src [int32] & dst [int64] kind didn't match", errs[0].Error())
+}
+
+func TestConvertNumber(t *testing.T) {
+	testcases := []struct {
+		in    interface{}
+		out   interface{}
+		value interface{}
+		err   string
+	}{
+		{in: int64(math.MaxInt64), out: uint64(0), value: uint64(math.MaxInt64)},
+		{in: uint64(math.MaxUint64), out: int64(0), err: "value [18446744073709551615] of [uint64] overflows [int64]"},
+		{in: int64(1<<53 + 1), out: float64(0), err: "value [9007199254740993] of [int64] loses precision in [float64]"},
+		{in: int32(1<<24 + 1), out: float32(0), err: "value [16777217] of [int32] loses precision in [float32]"},
+		{in: uint32(1 << 24), out: float32(0), value: float32(1 << 24)},
+		{in: float64(1 << 63), out: int64(0), err: "value [9.223372036854776e+18] of [float64] overflows [int64]"},
+		{in: math.NaN(), out: int(0), err: "value [NaN] of [float64] loses precision in [int]"},
+		{in: math.Inf(1), out: float32(0), value: float32(math.Inf(1))},
+		{in: float32(-2), out: uint(0), err: "value [-2] of [float32] overflows [uint]"},
+		{in: float32(255), out: uint8(0), value: uint8(255)},
+	}
+
+	for _, tc := range testcases {
+		v, err := convertNumber(reflect.ValueOf(tc.in), reflect.TypeOf(tc.out))
+		if tc.err != "" {
+			assertEqual(t, tc.err, err.Error())
+			continue
+		}
+
+		assertError(t, err)
+		assertEqual(t, tc.value, v.Interface())
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+var (
+	typeOfJSONMarshaler = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	typeOfStringer      = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+)
+
+// WithJSONMarshaler option makes `Map()` method to emit the opaque struct value
+// via its `json.Marshaler` implementation, `fmt.Stringer` is used as fallback.
+// Struct is opaque if it's not traversed, i.e. type is in `NoTraverseTypeList` or
+// has "notraverse" tag option, or it has only unexported fields. The JSON result
+// is embedded as `json.RawMessage`, so the output JSON has it as-is. Value is
+// mapped as-is if it implements neither or fails to marshal.
+// 		Example:
+//
+// 		// Archived	time.Time	`model:",notraverse"`
+// 		// field value appears as json.RawMessage(`"2017-01-02T15:04:05Z"`)
+// 		m, err := model.Map(src, model.WithJSONMarshaler())
+//
+func WithJSONMarshaler() Option {
+	return func(o *options) {
+		o.jsonMarshal = true
+	}
+}
+
+// isOpaque method reports the struct type has only unexported fields.
+func isOpaque(t reflect.Type) bool {
+	t = indirectType(t)
+	return t.Kind() == reflect.Struct && len(typeInfoOf(t).fields) == 0
+}
+
+// implements method reports the value implements the given interface type,
+// pointer receiver method is considered too.
+func implements(v reflect.Value, it reflect.Type) bool {
+	t := v.Type()
+	return t.Implements(it) || (t.Kind() != reflect.Ptr && reflect.PtrTo(t).Implements(it))
+}
+
+// marshalOpaque method returns the JSON or string form of the value, ok is
+// false if the value implements neither or fails to marshal.
+func marshalOpaque(v reflect.Value) (interface{}, bool) {
+	if !v.IsValid() || !v.CanInterface() {
+		return nil, false
+	}
+
+	if implements(v, typeOfJSONMarshaler) {
+		if !v.Type().Implements(typeOfJSONMarshaler) {
+			v = addressable(v).Addr()
+		}
+
+		if b, err := v.Interface().(json.Marshaler).MarshalJSON(); err == nil && json.Valid(b) {
+			return json.RawMessage(b), true
+		}
+	}
+
+	if implements(v, typeOfStringer) {
+		if !v.Type().Implements(typeOfStringer) {
+			v = addressable(v).Addr()
+		}
+
+		return v.Interface().(fmt.Stringer).String(), true
+	}
+
+	return nil, false
+}
@@ -0,0 +1,72 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+type sampleOpaqueVersion struct {
+	major, minor int
+}
+
+func (v sampleOpaqueVersion) String() string {
+	return string(rune('0'+v.major)) + "." + string(rune('0'+v.minor))
+}
+
+type sampleOpaqueMoney struct {
+	Amount   int
+	Currency string
+}
+
+func (m *sampleOpaqueMoney) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{"value": m.Amount, "ccy": m.Currency})
+}
+
+func TestMapJSONMarshaler(t *testing.T) {
+	type Release struct {
+		Version   sampleOpaqueVersion
+		Versions  []sampleOpaqueVersion
+		Price     sampleOpaqueMoney
+		Archived  time.Time `model:",notraverse"`
+		Published time.Time `model:",notraverse"`
+		Total     sampleOpaqueMoney
+	}
+
+	src := Release{
+		Version:  sampleOpaqueVersion{1, 2},
+		Versions: []sampleOpaqueVersion{{1, 0}},
+		Price:    sampleOpaqueMoney{Amount: 10, Currency: "USD"},
+		Archived: time.Date(2017, 1, 2, 15, 4, 5, 0, time.UTC),
+		Total:    sampleOpaqueMoney{Amount: 20, Currency: "INR"},
+	}
+
+	m, err := Map(src, WithJSONMarshaler(), WithNoTraverseType(sampleOpaqueMoney{}))
+	assertError(t, err)
+	assertEqual(t, "1.2", m["Version"])
+	assertEqual(t, []interface{}{"1.0"}, m["Versions"])
+	assertEqual(t, `{"ccy":"USD","value":10}`, string(m["Price"].(json.RawMessage)))
+	assertEqual(t, `"2017-01-02T15:04:05Z"`, string(m["Archived"].(json.RawMessage)))
+	assertEqual(t, `"0001-01-01T00:00:00Z"`, string(m["Published"].(json.RawMessage)))
+
+	b, err := json.Marshal(m)
+	assertError(t, err)
+	assertEqual(t, `{"Archived":"2017-01-02T15:04:05Z","Price":{"ccy":"USD","value":10},`+
+		`"Published":"0001-01-01T00:00:00Z","Total":{"ccy":"INR","value":20},"Version":"1.2","Versions":["1.0"]}`, string(b))
+
+	// traversed struct is mapped as usual
+	m, err = Map(src, WithJSONMarshaler())
+	assertError(t, err)
+	assertEqual(t, map[string]interface{}{"Amount": 10, "Currency": "USD"}, m["Price"])
+
+	// opaque value is mapped as-is by default, struct with only unexported
+	// fields is considered zero
+	m, err = Map(src)
+	assertError(t, err)
+	assertEqual(t, true, m["Version"].(sampleOpaqueVersion) == sampleOpaqueVersion{})
+	assertEqual(t, true, m["Archived"].(time.Time).Equal(src.Archived))
+}
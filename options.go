@@ -0,0 +1,638 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// Option is used to customize the go-model method behavior per call.
+// 		Example:
+//
+// 		m, err := model.Map(src, model.WithRedact(model.RedactOmit))
+//
+type Option func(o *options)
+
+// RedactMode is used to choose how the fields tagged with "redact" option
+// appear in the `Map()` result.
+type RedactMode uint8
+
+// Redact modes
+const (
+	// RedactMask replaces the field value with `RedactMaskValue`, it's default mode
+	RedactMask RedactMode = iota
+
+	// RedactOmit leaves out the field from the result
+	RedactOmit
+
+	// RedactNone includes the actual field value as-is
+	RedactNone
+)
+
+// RedactMaskValue is used in place of redacted field value.
+const RedactMaskValue = "***"
+
+// NilPointerMode is used to choose how the nil pointer fields appear in the
+// `Map()` result.
+type NilPointerMode uint8
+
+// Nil pointer modes
+const (
+	// NilPointerZero includes the zero value of the pointer type, i.e. typed nil
+	// pointer, it's default mode
+	NilPointerZero NilPointerMode = iota
+
+	// NilPointerNil includes the untyped nil, so the map value compares equal
+	// to nil and the output JSON has null
+	NilPointerNil
+
+	// NilPointerOmit leaves out the field from the result
+	NilPointerOmit
+)
+
+type options struct {
+	redact RedactMode
+	mask   *fieldMask
+
+	// except is the exclusion mask of map, see `Except()`
+	except *fieldMask
+
+	// textMarshal is true if the text form of value is used in map, see
+	// `WithTextMarshaler()`
+	textMarshal bool
+
+	// jsonMarshal is true if the opaque struct value is marshaled in map, see
+	// `WithJSONMarshaler()`
+	jsonMarshal bool
+
+	// singleAsSlice is true if the single value is populated into slice field
+	// in `FromMap()`, see `FromURLValues()`
+	singleAsSlice bool
+
+	// noTraverse types of the call
+	noTraverse map[reflect.Type]bool
+
+	// ctx is supplied to context-aware converters, see `CopyCtx()`
+	ctx context.Context
+
+	// converters are scoped converter sets of the call, see `WithConverters()`
+	converters []*Converters
+
+	// autoConvert is true if the convertible types are converted, see `WithAutoConvert()`
+	autoConvert bool
+
+	// numericConvert is true if the numbers are converted, see `WithNumericConvert()`
+	numericConvert bool
+
+	// weakTyping is true if the scalar values are coerced, see `WithWeakTyping()`
+	weakTyping bool
+
+	// profile is the mapping profile of the call, see `Profile.Copy()`
+	profile *Profile
+
+	// keyFormatter stringifies the map key in `Map()` result
+	keyFormatter KeyFormatter
+
+	// preserveAliasing is true if the shared pointers are copied once, see `WithPreserveAliasing()`
+	preserveAliasing bool
+
+	// unexported is true if the unexported fields are copied, see `WithUnexported()`
+	unexported bool
+
+	// accessors is true if the getter and setter methods are used, see `WithAccessors()`
+	accessors bool
+
+	// emptyAsZero is true if the zero length slice and map are zero, see `WithEmptyAsZero()`
+	emptyAsZero bool
+
+	// nilAsEmpty is true if the nil slice and map are copied as empty, see `WithNilAsEmpty()`
+	nilAsEmpty bool
+
+	// appendTo is true if the slices are appended to destination, see `WithAppend()`
+	appendTo bool
+
+	// mergeTo is true if the maps are merged into destination, see `WithMerge()`
+	mergeTo bool
+
+	// skipNilPtr is true if the nil source pointers are not copied, see `WithSkipNilPointers()`
+	skipNilPtr bool
+
+	// report is populated with per-field statistics of copy, see `WithReport()`
+	report *CopyReport
+
+	// mapping holds the state of single map process, see `Map()`
+	mapping *mapState
+
+	// failFast is true if the copy stops at first error, see `WithFailFast()`
+	failFast bool
+
+	// maxErrors is the maximum count of errors returned, see `WithMaxErrors()`
+	maxErrors int
+
+	// fieldHook intercepts the field copy, see `WithFieldHook()`
+	fieldHook FieldHook
+
+	// skip excludes the fields dynamically, see `SkipFunc()`
+	skip func(f reflect.StructField, v reflect.Value) bool
+
+	// jsonTags is true if the "json" tag is used in absence of "model" tag,
+	// see `WithJSONTags()`
+	jsonTags bool
+
+	// tag is the tag name of the call in place of "model", see `WithTagName()`
+	tag string
+
+	// keyCase transforms the field name keys of map, see `WithKeyCase()`
+	keyCase KeyCase
+
+	// nilPtr is the mode of nil pointer fields in map, see `WithNilPointers()`
+	nilPtr NilPointerMode
+
+	// derefPtr is true if the pointers are dereferenced in map, see `WithDerefPointers()`
+	derefPtr bool
+
+	// typedKeys is true if the map keys are not stringified, see `WithTypedMapKeys()`
+	typedKeys bool
+
+	// shallow is true if the field values are not mapped, see `MapShallow()`
+	shallow bool
+
+	// maxDepth is the nested struct levels to traverse, see `WithMaxDepth()`
+	maxDepth int
+
+	// depth is the nested struct level of map in process
+	depth int
+}
+
+// WithRedact option sets the `RedactMode` for the fields tagged with "redact" option.
+func WithRedact(mode RedactMode) Option {
+	return func(o *options) {
+		o.redact = mode
+	}
+}
+
+// WithDerefPointers option makes `Map()` method to dereference the pointer values
+// in the result, for eg.: `*string` field appears as "value" and `*int` as 5 instead
+// of pointer. So the map is directly usable with template engines and logging.
+// Nil pointer appears as zero value of the element type, unless `WithNilPointers()`
+// option is given.
+// 		Example:
+//
+// 		m, err := model.Map(src, model.WithDerefPointers())
+//
+func WithDerefPointers() Option {
+	return func(o *options) {
+		o.derefPtr = true
+	}
+}
+
+// WithNilPointers option sets the `NilPointerMode` for the nil pointer fields in
+// the `Map()` result, by default zero value of the pointer type is included. Field
+// with 'omitempty' option is left out regardless of the mode, nil embedded struct
+// pointer is not mapped with zero values of its fields in non-default mode.
+// 		Example:
+//
+// 		// nil pointer fields appear as nil in the map
+// 		m, err := model.Map(src, model.WithNilPointers(model.NilPointerNil))
+// 		if m["Age"] == nil {
+// 			fmt.Println("Age is not provided")
+// 		}
+//
+// 		// nil pointer fields are left out
+// 		m, err = model.Map(src, model.WithNilPointers(model.NilPointerOmit))
+//
+func WithNilPointers(mode NilPointerMode) Option {
+	return func(o *options) {
+		o.nilPtr = mode
+	}
+}
+
+// WithAutoConvert option converts the source field value into destination field
+// type using Go conversion rules, when no converter is registered for the types and
+// types are convertible. For eg.: `type UserID int64` to `int64`, `float32` to `float64`.
+// Slice and map elements are converted too. Integer to string conversion is not
+// applied, since Go treats it as rune conversion. Numbers are converted same as
+// `WithNumericConvert()` option.
+// 		Example:
+//
+// 		errs := model.Copy(&dst, src, model.WithAutoConvert())
+//
+func WithAutoConvert() Option {
+	return func(o *options) {
+		o.autoConvert = true
+	}
+}
+
+// WithPreserveAliasing option preserves the sharing semantics of source object
+// graph. Pointers which point to the same object are copied once and destination
+// fields point to the same copy, by default every pointer gets it's own copy.
+// 		Example:
+//
+// 		// src.Billing and src.Shipping point to the same address
+// 		result, err := model.Clone(src, model.WithPreserveAliasing())
+// 		order := result.(*Order)
+// 		fmt.Println(order.Billing == order.Shipping)
+//
+// 		// Output:
+// 		true
+//
+// Note: Slices and maps are not aliased, since they are copied as a whole.
+//
+func WithPreserveAliasing() Option {
+	return func(o *options) {
+		o.preserveAliasing = true
+	}
+}
+
+// WithEmptyAsZero option treats the zero length slice and map as zero value, by
+// default only nil slice and map is zero value. It's applicable to 'omitempty'
+// option of `Copy()` and `Map()` methods, `IsZero()` and `HasZero()` methods.
+// 		Example:
+//
+// 		// Tags []string{} of 'omitempty' field doesn't overwrite the destination
+// 		errs := model.Copy(&dst, src, model.WithEmptyAsZero())
+//
+// 		// Tags []string{} is zero value
+// 		result := model.HasZero(src, model.WithEmptyAsZero())
+//
+func WithEmptyAsZero() Option {
+	return func(o *options) {
+		o.emptyAsZero = true
+	}
+}
+
+// isZero method reports the field is zero value as per options of the call.
+func (o *options) isZero(f reflect.Value) bool {
+	if isFieldZero(f) {
+		return true
+	}
+
+	if o == nil || !o.emptyAsZero {
+		return false
+	}
+
+	return (f.Kind() == reflect.Slice || f.Kind() == reflect.Map) && f.Len() == 0
+}
+
+// WithNilAsEmpty option copies the nil slice and map as allocated empty collection
+// into destination of `Copy()` method and result of `Map()` method, so the JSON
+// output has "[]" or "{}" instead of "null".
+// 		Example:
+//
+// 		m, err := model.Map(src, model.WithNilAsEmpty())
+//
+func WithNilAsEmpty() Option {
+	return func(o *options) {
+		o.nilAsEmpty = true
+	}
+}
+
+// emptyOf method returns the allocated empty collection of slice and map type,
+// if it's applicable as per options of the call.
+func (o *options) emptyOf(t reflect.Type) (reflect.Value, bool) {
+	if !o.nilAsEmpty {
+		return reflect.Value{}, false
+	}
+
+	switch t.Kind() {
+	case reflect.Slice:
+		return reflect.MakeSlice(t, 0, 0), true
+	case reflect.Map:
+		return reflect.MakeMap(t), true
+	}
+
+	return reflect.Value{}, false
+}
+
+// WithAppend option appends the source slice elements to the existing destination
+// slice instead of replacing it, for all the slice fields. It's same as "append"
+// tag option on the field, useful for accumulating results from multiple sources.
+// 		Example:
+//
+// 		for _, page := range pages {
+// 			errs := model.Copy(&result, page, model.WithAppend())
+// 		}
+//
+func WithAppend() Option {
+	return func(o *options) {
+		o.appendTo = true
+	}
+}
+
+// WithMerge option merges the source map entries into the existing destination
+// map instead of replacing it, for all the map fields. Source wins on key conflict.
+// It's same as "merge" tag option on the field.
+// 		Example:
+//
+// 		errs := model.Copy(&settings, overrides, model.WithMerge())
+//
+func WithMerge() Option {
+	return func(o *options) {
+		o.mergeTo = true
+	}
+}
+
+// WithSkipNilPointers option leaves the destination field untouched when the source
+// pointer field is nil, by default destination is set to zero value unless the field
+// has 'omitempty' option. It's useful for merge or patch workflows.
+// 		Example:
+//
+// 		// only the provided fields of patch request are applied
+// 		errs := model.Copy(&user, patchRequest, model.WithSkipNilPointers())
+//
+func WithSkipNilPointers() Option {
+	return func(o *options) {
+		o.skipNilPtr = true
+	}
+}
+
+// WithFailFast option stops the copy at first error and returns it immediately,
+// by default copy continues and collects all the errors. Destination is partially
+// copied, fields processed before the error are retained.
+// 		Example:
+//
+// 		if errs := model.Copy(&order, request, model.WithFailFast()); errs != nil {
+// 			return errs[0]
+// 		}
+//
+func WithFailFast() Option {
+	return func(o *options) {
+		o.failFast = true
+	}
+}
+
+// SkipFunc option excludes the fields dynamically for which the given predicate
+// returns true, without modifying the struct definitions. Predicate is called with
+// the source struct field and its value, by `Copy()`, `Clone()` and `Map()` methods.
+// Excluded field stays untouched in the destination and not included in the map.
+// 		Example:
+//
+// 		// exclude the fields tagged as internal
+// 		errs := model.Copy(&dst, src, model.SkipFunc(func(f reflect.StructField, v reflect.Value) bool {
+// 			return f.Tag.Get("access") == "internal"
+// 		}))
+//
+// 		// exclude the empty strings
+// 		m, err := model.Map(src, model.SkipFunc(func(f reflect.StructField, v reflect.Value) bool {
+// 			return v.Kind() == reflect.String && v.Len() == 0
+// 		}))
+//
+func SkipFunc(skip func(f reflect.StructField, v reflect.Value) bool) Option {
+	return func(o *options) {
+		o.skip = skip
+	}
+}
+
+// isSkipped method reports the field is excluded by `SkipFunc()` option.
+func (o *options) isSkipped(f reflect.StructField, v reflect.Value) bool {
+	return o != nil && o.skip != nil && o.skip(f, v)
+}
+
+// MoreErrors is the last error of the result when the errors are beyond the
+// limit of `WithMaxErrors()` option, value is the count of left out errors
+// found before the copy is stopped.
+type MoreErrors int
+
+// Error method returns the left out errors count as "+N more errors".
+func (e MoreErrors) Error() string {
+	return fmt.Sprintf("+%d more errors", int(e))
+}
+
+// WithMaxErrors option limits the count of errors returned by copy, the copy is
+// stopped once the errors are beyond the limit, so the large invalid input is not
+// processed entirely. The errors beyond the limit are left out and `MoreErrors`
+// is appended with left out count. See `WithFailFast()` to stop at first error.
+// Zero or negative value means no limit, it's default.
+// 		Example:
+//
+// 		errs := model.Copy(&dst, src, model.WithMaxErrors(10))
+// 		if more, ok := errs[len(errs)-1].(model.MoreErrors); ok {
+// 			fmt.Println("Left out errors:", int(more))
+// 		}
+//
+func WithMaxErrors(n int) Option {
+	return func(o *options) {
+		o.maxErrors = n
+	}
+}
+
+// limitErrors method returns the errors within limit of `WithMaxErrors()`,
+// followed by `MoreErrors` if any of them left out.
+func (o *options) limitErrors(errs []error) []error {
+	if o.maxErrors <= 0 || len(errs) <= o.maxErrors {
+		return errs
+	}
+
+	return append(errs[:o.maxErrors:o.maxErrors], MoreErrors(len(errs)-o.maxErrors))
+}
+
+// WithJSONTags option makes `Map()` and `FromMap()` methods to use the "json" tag
+// of the field when "model" tag is absent, so the structs carrying json tags
+// need not be tagged again. The json tag name and its options like "-" and
+// "omitempty" are honored.
+// 		Example:
+//
+// 		type Product struct {
+// 			Name		string	`json:"name"`
+// 			Description	string	`json:"desc,omitempty"`
+// 			Secret		string	`json:"-"`
+// 			SKU		string	`json:"sku" model:"productSku"`
+// 		}
+//
+// 		// map[name:go-model productSku:GM-01]
+// 		m, err := model.Map(product, model.WithJSONTags())
+//
+func WithJSONTags() Option {
+	return func(o *options) {
+		o.jsonTags = true
+	}
+}
+
+// WithTagName option uses the given tag name in place of "model" tag for the
+// call, so the teams can pick their own tag namespace or reuse the existing tags.
+// Tag options are same as "model" tag. It's applicable to the methods which accept
+// `Option`, such as copy, map, zero check, `FromStringMap()`, `FromEnv()` and
+// `Unflatten()` methods. Methods without `Option`, for eg.: `Validate()`, `Zero()`,
+// `ZeroFields()`, `Pick()`, `TemplateData()`, `CopyWithAudit()`, `VerifyCopy()` and
+// `Fill()` use "model" tag; `Get()` and `Set()` methods use the field name.
+// 		Example:
+//
+// 		type Product struct {
+// 			Name	string	`mapper:"productName"`
+// 			Secret	string	`mapper:"-"`
+// 		}
+//
+// 		m, err := model.Map(product, model.WithTagName("mapper"))
+//
+// 		errs := model.Copy(&dst, product, model.WithTagName("mapper"))
+//
+func WithTagName(name string) Option {
+	return func(o *options) {
+		o.tag = name
+	}
+}
+
+// tagName method returns the tag name of the call, default is `TagName`.
+func (o *options) tagName() string {
+	if o == nil || isStringEmpty(o.tag) {
+		return TagName
+	}
+
+	return o.tag
+}
+
+// mapTagNames method returns the tag names of map methods in the order of
+// precedence, "json" tag is fallback if enabled.
+func (o *options) mapTagNames() []string {
+	if o != nil && o.jsonTags {
+		return []string{o.tagName(), "json"}
+	}
+
+	return []string{o.tagName()}
+}
+
+// tagsOf method returns the parsed tags of struct fields as per tag name of
+// the call.
+func (o *options) tagsOf(ti *typeInfo) []*tag {
+	return ti.tagsOf([]string{o.tagName()})
+}
+
+// tagOf method returns the parsed tag of struct field as per tag name of the call.
+func (o *options) tagOf(f reflect.StructField) *tag {
+	return newTag(f.Tag.Get(o.tagName()))
+}
+
+// mapTagsOf method returns the parsed tags of struct fields as per tag names
+// of map methods.
+func (o *options) mapTagsOf(ti *typeInfo) []*tag {
+	return ti.tagsOf(o.mapTagNames())
+}
+
+// mapTagOf method returns the parsed tag of struct field as per tag names of
+// map methods.
+func (o *options) mapTagOf(f reflect.StructField) *tag {
+	return newTag(lookupTag(f.Tag, o.mapTagNames()))
+}
+
+// KeyFormatter is used to stringify the map key in the `Map()` result.
+type KeyFormatter func(key reflect.Value) string
+
+// WithMapKeyFormatter option sets the `KeyFormatter` for map keys in the `Map()`
+// result, by default key is formatted with "%v" verb. It's useful for the map
+// keyed by composite types.
+// 		Example:
+//
+// 		m, err := model.Map(src, model.WithMapKeyFormatter(func(key reflect.Value) string {
+// 			k := key.Interface().(GeoPoint)
+// 			return fmt.Sprintf("%v,%v", k.Lat, k.Lng)
+// 		}))
+//
+func WithMapKeyFormatter(f KeyFormatter) Option {
+	return func(o *options) {
+		o.keyFormatter = f
+	}
+}
+
+// WithTypedMapKeys option makes `Map()` method to preserve the key type of map
+// fields, by default all the keys are stringified. Map with non-string key type
+// appears as `map[interface{}]interface{}` with original key values, for eg.:
+// `map[int]int{2: 4}` appears as `map[interface{}]interface{}{2: 4}`. Map with
+// string key type remains `map[string]interface{}`. `KeyFormatter` is not applied
+// when this option is given.
+// 		Example:
+//
+// 		m, err := model.Map(src, model.WithTypedMapKeys())
+//
+func WithTypedMapKeys() Option {
+	return func(o *options) {
+		o.typedKeys = true
+	}
+}
+
+// WithMaxDepth option limits the traversal of `Copy()` and `Map()` methods to
+// the given levels of nested struct, deeper struct is treated same as "notraverse"
+// and its value is taken as-is. Top level struct fields are at level 1 and
+// embedded struct fields are at the same level of its parent. Zero or negative
+// value means no limit, which is the default.
+// 		Example:
+//
+// 		// Address.Geo struct field value appears as struct in the result
+// 		m, err := model.Map(src, model.WithMaxDepth(2))
+//
+func WithMaxDepth(n int) Option {
+	return func(o *options) {
+		o.maxDepth = n
+	}
+}
+
+// beyondDepth method reports the given nested struct level is beyond the
+// max depth of the call.
+func (o *options) beyondDepth(level int) bool {
+	return o.maxDepth > 0 && level >= o.maxDepth
+}
+
+// deeper method returns the copy of options for the next nested struct level.
+func (o *options) deeper() *options {
+	if o.maxDepth <= 0 {
+		return o
+	}
+
+	no := *o
+	no.depth++
+	return &no
+}
+
+// formatKey method stringifies the map key with `KeyFormatter` of the call,
+// nil pointer key is formatted as "<nil>".
+func (o *options) formatKey(key reflect.Value) string {
+	if o.keyFormatter != nil {
+		return o.keyFormatter(key)
+	}
+
+	if isPtr(key) && !key.IsNil() {
+		key = key.Elem()
+	}
+
+	return fmt.Sprintf("%v", key.Interface())
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+// withMask method returns the copy of options with given field mask.
+func (o *options) withMask(fm *fieldMask) *options {
+	if o.mask == fm {
+		return o
+	}
+
+	no := *o
+	no.mask = fm
+	return &no
+}
+
+// withExcept method returns the copy of options with given exclusion mask.
+func (o *options) withExcept(fm *fieldMask) *options {
+	if o.except == fm {
+		return o
+	}
+
+	no := *o
+	no.except = fm
+	return &no
+}
+
+// elem method returns the copy of options for the elements of slice, array
+// or map.
+func (o *options) elem() *options {
+	return o.withMask(o.mask.elem()).withExcept(o.except.elem())
+}
@@ -0,0 +1,131 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"reflect"
+	"sort"
+)
+
+// KV is the key and value pair of `MapOrdered()` result.
+type KV struct {
+	Key   string
+	Value interface{}
+}
+
+// MapOrdered method converts the given `struct` into key and value pairs same
+// as `Map()` method, in the struct field declaration order. Nested struct value
+// is key and value pairs too, so the result is deterministic for signatures,
+// canonical forms and human readable configs. Embedded struct fields are at the
+// position of embedded field, and the keys which are not of exported fields, for
+// eg.: `WithUnexported()` option, are at the end in sorted order. It accepts all
+// the options of `Map()` method.
+// 		Example:
+//
+// 		kvs, _ := model.MapOrdered(src)
+// 		for _, kv := range kvs {
+// 			fmt.Println(kv.Key, "=", kv.Value)
+// 		}
+//
+func MapOrdered(s interface{}, opts ...Option) ([]KV, error) {
+	m, err := Map(s, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return orderedKVs(m, indirect(valueOf(s)).Type(), newOptions(opts)), nil
+}
+
+// orderedKVs method returns the key and value pairs of mapped struct in the
+// field declaration order of given struct type.
+func orderedKVs(m map[string]interface{}, t reflect.Type, o *options) []KV {
+	kvs := make([]KV, 0, len(m))
+	seen := map[string]bool{}
+	appendOrderedKVs(&kvs, seen, m, t, o)
+
+	// remaining keys are not of exported fields
+	var keys []string
+	for k := range m {
+		if !seen[k] {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		kvs = append(kvs, KV{Key: k, Value: m[k]})
+	}
+
+	return kvs
+}
+
+func appendOrderedKVs(kvs *[]KV, seen map[string]bool, m map[string]interface{}, t reflect.Type, o *options) {
+	for _, f := range typeInfoOf(t).fields {
+		tag := o.mapTagOf(f)
+		if tag.isOmitField() {
+			continue
+		}
+
+		keyName := o.keyNameOf(tag, f)
+		v, found := m[keyName]
+
+		// embedded struct fields are mapped at parent level
+		ft := deepIndirectType(f.Type)
+		if !found && (f.Anonymous || tag.isInline()) && ft.Kind() == reflect.Struct {
+			appendOrderedKVs(kvs, seen, m, ft, o)
+			continue
+		}
+
+		if !found || seen[keyName] {
+			continue
+		}
+		seen[keyName] = true
+
+		*kvs = append(*kvs, KV{Key: keyName, Value: orderedVal(v, ft, o)})
+	}
+}
+
+// orderedVal method converts the mapped nested struct value into key and value
+// pairs, slice and array elements are converted too.
+func orderedVal(v interface{}, t reflect.Type, o *options) interface{} {
+	if t.Kind() == reflect.Struct {
+		switch m := v.(type) {
+		case map[string]interface{}:
+			return orderedKVs(m, t, o)
+		case *map[string]interface{}:
+			if m != nil {
+				return orderedKVs(*m, t, o)
+			}
+		}
+
+		return v
+	}
+
+	if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+		return v
+	}
+
+	et := deepIndirectType(t.Elem())
+	rv := valueOf(v)
+	if et.Kind() != reflect.Struct || (rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array) {
+		return v
+	}
+
+	elems := make([]interface{}, rv.Len())
+	for i := range elems {
+		elems[i] = orderedVal(rv.Index(i).Interface(), et, o)
+	}
+
+	return elems
+}
+
+// deepIndirectType method returns the element type of multi-level pointer.
+func deepIndirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t
+}
@@ -0,0 +1,71 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMapOrdered(t *testing.T) {
+	type Address struct {
+		Zip  string `model:"zip"`
+		City string `model:"city"`
+	}
+
+	type Audit struct {
+		CreatedBy string
+		UpdatedBy string
+	}
+
+	type User struct {
+		Name string
+		Audit
+		Email     string `model:"email"`
+		Secret    string `model:"-"`
+		Address   Address
+		Home      *Address
+		Addresses []Address
+		Age       int
+	}
+
+	src := User{
+		Name:      "jeeva",
+		Audit:     Audit{CreatedBy: "admin", UpdatedBy: "system"},
+		Email:     "jeeva@myjeeva.com",
+		Address:   Address{Zip: "600001", City: "Chennai"},
+		Home:      &Address{Zip: "625001", City: "Madurai"},
+		Addresses: []Address{{Zip: "600002", City: "Chennai"}},
+		Age:       30,
+	}
+
+	kvs, err := MapOrdered(src)
+	assertError(t, err)
+
+	var keys []string
+	for _, kv := range kvs {
+		keys = append(keys, kv.Key)
+	}
+	assertEqual(t, []string{"Name", "CreatedBy", "UpdatedBy", "email", "Address", "Home", "Addresses", "Age"}, keys)
+
+	address := []KV{{Key: "zip", Value: "600001"}, {Key: "city", Value: "Chennai"}}
+	assertEqual(t, true, len(kvs[4].Value.([]KV)) == 2)
+	for i, kv := range kvs[4].Value.([]KV) {
+		assertEqual(t, true, kv == address[i])
+	}
+	assertEqual(t, "city", kvs[5].Value.([]KV)[1].Key)
+	assertEqual(t, "zip", kvs[6].Value.([]interface{})[0].([]KV)[0].Key)
+	assertEqual(t, 30, kvs[7].Value)
+
+	// options of map are applied
+	kvs, err = MapOrdered(src, Only("Age", "Name"), WithSnakeCaseKeys())
+	assertError(t, err)
+	assertEqual(t, 2, len(kvs))
+	assertEqual(t, true, kvs[0] == KV{Key: "name", Value: "jeeva"})
+	assertEqual(t, true, kvs[1] == KV{Key: "age", Value: 30})
+
+	_, err = MapOrdered(nil)
+	assertEqual(t, true, errors.Is(err, ErrNilInput))
+}
@@ -0,0 +1,410 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var (
+	errPathNotExists   = newError(ErrFieldNotFound, "does not exists")
+	errPathNotSettable = newError(ErrNotSettable, "cannot be settable")
+	errTypeNotMatch    = newError(ErrTypeMismatch, "type/kind did not match")
+	errPathNilValue    = newError(ErrFieldNotFound, "cannot traverse nil value")
+)
+
+// MaxPathIndex is the maximum slice index of the field path which grows the
+// slice in `Set()`, `Unflatten()` methods, so the slice cannot be grown
+// arbitrarily by the path.
+const MaxPathIndex = 1000
+
+// assignFunc assigns the value into field at the end of field path.
+type assignFunc func(fv, val reflect.Value) error
+
+// pathSegment is one part of field path expression, it's either field name
+// or index/key enclosed in brackets.
+//
+// For example: "Items[2].Meta[key]" => Items, [2], Meta, [key]
+type pathSegment struct {
+	name    string
+	index   string
+	isIndex bool
+}
+
+func parsePath(path string) ([]pathSegment, error) {
+	if isStringEmpty(path) {
+		return nil, newError(ErrFieldNotFound, "invalid path '%v'", path)
+	}
+
+	var segs []pathSegment
+	for i := 0; i < len(path); {
+		switch path[i] {
+		case '[':
+			end := strings.IndexByte(path[i:], ']')
+			if end == -1 {
+				return nil, newError(ErrFieldNotFound, "invalid path '%v', missing ']'", path)
+			}
+
+			segs = append(segs, pathSegment{index: path[i+1 : i+end], isIndex: true})
+			i += end + 1
+		case '.':
+			if i == 0 || i == len(path)-1 || path[i+1] == '.' || path[i+1] == '[' {
+				return nil, newError(ErrFieldNotFound, "invalid path '%v'", path)
+			}
+			i++
+		default:
+			end := strings.IndexAny(path[i:], ".[")
+			if end == -1 {
+				end = len(path) - i
+			}
+
+			name := path[i : i+end]
+			if strings.ContainsRune(name, ']') {
+				return nil, newError(ErrFieldNotFound, "invalid path '%v', missing '['", path)
+			}
+
+			segs = append(segs, pathSegment{name: name})
+			i += end
+		}
+	}
+
+	return segs, nil
+}
+
+// lookupField method finds the field on given struct value by name. The name is
+// matched against field name, or key name (see `Map()` method) as per keyTag if
+// it's not empty.
+// Fields of embedded struct are looked up at same level as represented by Go,
+// nil embedded struct pointer is allocated if alloc is true.
+func lookupField(sv reflect.Value, name, keyTag string, alloc bool) (reflect.Value, bool) {
+	fields := modelFields(sv)
+
+	for _, f := range fields {
+		if !isStringEmpty(keyTag) {
+			tag := newTag(f.Tag.Get(keyTag))
+			if !tag.isOmitField() && tag.keyName(f) == name {
+				return sv.FieldByIndex(f.Index), true
+			}
+		} else if f.Name == name {
+			return sv.FieldByIndex(f.Index), true
+		}
+	}
+
+	// promoted fields of embedded struct
+	for _, f := range fields {
+		if !f.Anonymous {
+			continue
+		}
+
+		fv := sv.FieldByIndex(f.Index)
+		if isPtr(fv) && fv.IsNil() {
+			if !alloc || !fv.CanSet() || fv.Type().Elem().Kind() != reflect.Struct {
+				continue
+			}
+
+			// allocate only if the field is found
+			nv := reflect.New(fv.Type().Elem())
+			if v, found := lookupField(nv.Elem(), name, keyTag, alloc); found {
+				fv.Set(nv)
+				return v, true
+			}
+
+			continue
+		}
+
+		fv = indirect(fv)
+		if fv.Kind() != reflect.Struct {
+			continue
+		}
+
+		if v, found := lookupField(fv, name, keyTag, alloc); found {
+			return v, true
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+// getPath method returns the value of field path from given value, pointers
+// and interfaces are dereferenced along the way.
+func getPath(v reflect.Value, segs []pathSegment) (reflect.Value, error) {
+	for _, seg := range segs {
+		for isPtr(v) || isInterface(v) {
+			if v.IsNil() {
+				return reflect.Value{}, errPathNilValue
+			}
+			v = v.Elem()
+		}
+
+		if !seg.isIndex {
+			if v.Kind() != reflect.Struct {
+				return reflect.Value{}, newError(ErrFieldNotFound, "cannot access '%v' on [%v]", seg.name, v.Type())
+			}
+
+			fv, found := lookupField(v, seg.name, "", false)
+			if !found {
+				return reflect.Value{}, errPathNotExists
+			}
+
+			v = fv
+			continue
+		}
+
+		switch v.Kind() {
+		case reflect.Slice, reflect.Array:
+			i, err := strconv.Atoi(seg.index)
+			if err != nil || i < 0 {
+				return reflect.Value{}, newError(ErrFieldNotFound, "invalid index '%v'", seg.index)
+			}
+
+			if i >= v.Len() {
+				return reflect.Value{}, newError(ErrFieldNotFound, "index '%v' out of range", i)
+			}
+
+			v = v.Index(i)
+		case reflect.Map:
+			kv, err := parseString(seg.index, v.Type().Key())
+			if err != nil {
+				return reflect.Value{}, newError(ErrFieldNotFound, "invalid key '%v', %v", seg.index, err)
+			}
+
+			mv := v.MapIndex(kv)
+			if !mv.IsValid() {
+				return reflect.Value{}, newError(ErrFieldNotFound, "key '%v' does not exists", seg.index)
+			}
+
+			v = mv
+		default:
+			return reflect.Value{}, newError(ErrFieldNotFound, "cannot index [%v]", v.Type())
+		}
+	}
+
+	return v, nil
+}
+
+// setPath method sets the value into field path of given value, nil pointer,
+// slice and map are allocated along the way. Allocations are reverted if the
+// value cannot be set, so the failed set leaves the given value untouched.
+func setPath(v reflect.Value, segs []pathSegment, val reflect.Value, keyTag string, assign assignFunc) error {
+	if len(segs) == 0 {
+		return assign(v, val)
+	}
+
+	if isPtr(v) {
+		if !v.IsNil() {
+			return setPath(v.Elem(), segs, val, keyTag, assign)
+		}
+
+		if !v.CanSet() {
+			return errPathNotSettable
+		}
+
+		v.Set(reflect.New(v.Type().Elem()))
+		if err := setPath(v.Elem(), segs, val, keyTag, assign); err != nil {
+			v.Set(reflect.Zero(v.Type()))
+			return err
+		}
+
+		return nil
+	}
+
+	// interface value is not addressable, so set into copy and put it back
+	if isInterface(v) {
+		if v.IsNil() {
+			return newError(ErrFieldNotFound, "cannot traverse nil interface")
+		}
+
+		if isPtr(v.Elem()) {
+			return setPath(v.Elem(), segs, val, keyTag, assign)
+		}
+
+		ev := reflect.New(v.Elem().Type()).Elem()
+		ev.Set(v.Elem())
+		if err := setPath(ev, segs, val, keyTag, assign); err != nil {
+			return err
+		}
+
+		if !v.CanSet() {
+			return errPathNotSettable
+		}
+		v.Set(ev)
+		return nil
+	}
+
+	seg := segs[0]
+	if !seg.isIndex {
+		if v.Kind() != reflect.Struct {
+			return newError(ErrFieldNotFound, "cannot access '%v' on [%v]", seg.name, v.Type())
+		}
+
+		// field of nil embedded struct pointer is allocated by lookup, struct
+		// is restored if the value cannot be set
+		var prev reflect.Value
+		fv, found := lookupField(v, seg.name, keyTag, false)
+		if !found && v.CanSet() {
+			prev = reflect.New(v.Type()).Elem()
+			prev.Set(v)
+			fv, found = lookupField(v, seg.name, keyTag, true)
+		}
+
+		if !found {
+			return errPathNotExists
+		}
+
+		if err := setPath(fv, segs[1:], val, keyTag, assign); err != nil {
+			if prev.IsValid() {
+				v.Set(prev)
+			}
+			return err
+		}
+
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		i, err := strconv.Atoi(seg.index)
+		if err != nil || i < 0 {
+			return newError(ErrFieldNotFound, "invalid index '%v'", seg.index)
+		}
+
+		if i < v.Len() {
+			return setPath(v.Index(i), segs[1:], val, keyTag, assign)
+		}
+
+		if v.Kind() == reflect.Array {
+			return newError(ErrFieldNotFound, "index '%v' out of range", i)
+		}
+
+		if i > MaxPathIndex {
+			return newError(ErrFieldNotFound, "index '%v' exceeds maximum index %d", i, MaxPathIndex)
+		}
+
+		if !v.CanSet() {
+			return errPathNotSettable
+		}
+
+		ns := reflect.MakeSlice(v.Type(), i+1, i+1)
+		reflect.Copy(ns, v)
+		if err := setPath(ns.Index(i), segs[1:], val, keyTag, assign); err != nil {
+			return err
+		}
+
+		v.Set(ns)
+		return nil
+	case reflect.Map:
+		kv, err := parseString(seg.index, v.Type().Key())
+		if err != nil {
+			return newError(ErrFieldNotFound, "invalid key '%v', %v", seg.index, err)
+		}
+
+		if v.IsNil() && !v.CanSet() {
+			return errPathNotSettable
+		}
+
+		// map element is not addressable, so set into copy and put it back
+		ev := reflect.New(v.Type().Elem()).Elem()
+		if cv := v.MapIndex(kv); cv.IsValid() {
+			ev.Set(cv)
+		}
+
+		if err := setPath(ev, segs[1:], val, keyTag, assign); err != nil {
+			return err
+		}
+
+		if v.IsNil() {
+			v.Set(reflect.MakeMap(v.Type()))
+		}
+		v.SetMapIndex(kv, ev)
+		return nil
+	}
+
+	return newError(ErrFieldNotFound, "cannot index [%v]", v.Type())
+}
+
+// assignValue method sets the value into field with registered converter or
+// pointer adaptation; string value is parsed into field type as last resort.
+func assignValue(fv, val reflect.Value) error {
+	return newOptions(nil).assignValue(fv, val)
+}
+
+// assignValue method is same as `assignValue` and applies the scoped converters
+// of the call too.
+func (o *options) assignValue(fv, val reflect.Value) error {
+	if !fv.CanSet() {
+		return errPathNotSettable
+	}
+
+	ft := fv.Type()
+
+	if !val.IsValid() {
+		fv.Set(reflect.Zero(ft))
+		return nil
+	}
+
+	if o.conversionExists(val.Type(), ft) {
+		v, err := o.convertValue(val, ft)
+		if err != nil {
+			return err
+		}
+		fv.Set(v)
+		return nil
+	}
+
+	if val.Type().AssignableTo(ft) {
+		fv.Set(val)
+		return nil
+	}
+
+	if ft.Kind() == reflect.Ptr && val.Type().AssignableTo(ft.Elem()) {
+		pv := reflect.New(ft.Elem())
+		pv.Elem().Set(val)
+		fv.Set(pv)
+		return nil
+	}
+
+	if isPtr(val) && val.Type().Elem().AssignableTo(ft) {
+		if val.IsNil() {
+			fv.Set(reflect.Zero(ft))
+		} else {
+			fv.Set(val.Elem())
+		}
+		return nil
+	}
+
+	if val.Kind() == reflect.String {
+		v, err := o.parseString(val.String(), ft)
+		if err != nil {
+			return err
+		}
+		fv.Set(v)
+		return nil
+	}
+
+	return newError(ErrTypeMismatch, "type [%v] is not assignable to [%v]", val.Type(), ft)
+}
+
+// assignExact method sets the value into field only if the type is matched,
+// pointer value is dereferenced.
+func assignExact(fv, val reflect.Value) error {
+	if !fv.CanSet() {
+		return errPathNotSettable
+	}
+
+	if isPtr(val) {
+		val = val.Elem()
+	}
+
+	if (fv.Kind() != val.Kind()) || fv.Type() != val.Type() {
+		return errTypeNotMatch
+	}
+
+	// assign the given value
+	fv.Set(val)
+
+	return nil
+}
@@ -0,0 +1,66 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import "reflect"
+
+// Pick method converts the given `struct` into `map[string]interface{}` same as
+// `Map()` method, and keeps only the given fields in the result. Name is either
+// field name or key name of the field.
+// 		Example:
+//
+// 		m, _ := model.Pick(user, "Name", "Email")
+// 		fmt.Println(m)
+//
+// 		// Output:
+// 		map[Name:Jeeva email:jeeva@myjeeva.com]
+//
+func Pick(s interface{}, names ...string) (map[string]interface{}, error) {
+	return filterMap(s, names, true)
+}
+
+// Omit method converts the given `struct` into `map[string]interface{}` same as
+// `Map()` method, and leaves out the given fields from the result. Name is either
+// field name or key name of the field.
+// 		Example:
+//
+// 		m, _ := model.Omit(user, "Password")
+// 		fmt.Println(m)
+//
+func Omit(s interface{}, names ...string) (map[string]interface{}, error) {
+	return filterMap(s, names, false)
+}
+
+func filterMap(s interface{}, names []string, keep bool) (map[string]interface{}, error) {
+	m, err := Map(s)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := mapKeysOf(indirect(valueOf(s)).Type(), names)
+	for k := range m {
+		if keys[k] != keep {
+			delete(m, k)
+		}
+	}
+
+	return m, nil
+}
+
+// mapKeysOf method resolves the given names into map keys, name is either
+// field name or key name of the field.
+func mapKeysOf(t reflect.Type, names []string) map[string]bool {
+	keys := map[string]bool{}
+	for _, name := range names {
+		if f, found := structField(t, name); found && f.PkgPath == "" {
+			keys[newTag(f.Tag.Get(TagName)).keyName(f)] = true
+			continue
+		}
+
+		keys[name] = true
+	}
+
+	return keys
+}
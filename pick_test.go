@@ -0,0 +1,37 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import "testing"
+
+func TestPickAndOmit(t *testing.T) {
+	type Base struct {
+		ID int `model:"id"`
+	}
+
+	type User struct {
+		Base
+		Name     string
+		Email    string `model:"email"`
+		Password string `model:"password"`
+	}
+
+	src := User{Base: Base{ID: 1}, Name: "Jeeva", Email: "jeeva@myjeeva.com", Password: "s3cr3t"}
+
+	m, err := Pick(src, "Name", "email", "ID", "NotExists")
+	assertError(t, err)
+	assertEqual(t, map[string]interface{}{"Name": "Jeeva", "email": "jeeva@myjeeva.com", "id": 1}, m)
+
+	m, err = Omit(&src, "Password", "id")
+	assertError(t, err)
+	assertEqual(t, map[string]interface{}{"Name": "Jeeva", "email": "jeeva@myjeeva.com"}, m)
+
+	m, err = Pick(src)
+	assertError(t, err)
+	assertEqual(t, 0, len(m))
+
+	_, err = Omit(nil, "Password")
+	assertEqual(t, "Invalid input <nil>", err.Error())
+}
@@ -0,0 +1,238 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"reflect"
+	"sync"
+)
+
+// Plan is the pre-compiled copy plan between source and destination struct
+// types. It resolves the field indices and tag options once, so the repeated
+// copy on hot paths doesn't look up fields by name and parse tags again.
+// Plan is safe for concurrent use.
+type Plan struct {
+	srcType reflect.Type
+	dstType reflect.Type
+	fields  []planField
+}
+
+// planField is the resolved source field and it's destination field.
+type planField struct {
+	field reflect.StructField
+	tag   *tag
+
+	// dstIndex is index sequence of destination field, nil if it does not exists
+	dstIndex []int
+
+	// dstOwner is the struct type which declares the destination field
+	dstOwner reflect.Type
+
+	// conv is the named converter of source or destination field "conv" option
+	conv string
+
+	// timeFormat is the layout of source or destination field "timefmt" option
+	timeFormat string
+
+	// appendTo is true if source or destination field has "append" option
+	appendTo bool
+
+	// mergeTo is true if source or destination field has "merge" option
+	mergeTo bool
+}
+
+type planKey struct {
+	src reflect.Type
+	dst reflect.Type
+
+	// tag is the tag name of plan, empty for default `TagName`
+	tag string
+}
+
+// planCache keeps the compiled copy plans, nested struct of `Copy()` and
+// `Clone()` methods are copied with plan too.
+var planCache sync.Map // map[planKey]*Plan
+
+// CompilePlan method compiles the copy plan from source `struct` type to destination
+// `struct` type, input can be either value or pointer. Then `Plan.Copy()` method
+// works same as `Copy()` method without resolving fields and tags per call.
+// 		Example:
+//
+// 		// compile once
+// 		plan, err := model.CompilePlan(dto.Product{}, Product{})
+//
+// 		// copy on hot path
+// 		errs := plan.Copy(&product, &productDto)
+//
+// Note: Converters are looked up at the time of copy, since conditional converter
+// depends on the value.
+//
+func CompilePlan(src, dst interface{}) (*Plan, error) {
+	if src == nil || dst == nil {
+		return nil, newError(ErrNilInput, "Source or Destination is nil")
+	}
+
+	st, dt := structTypeOf(src), structTypeOf(dst)
+	if st == nil || dt == nil {
+		return nil, newError(ErrNotStruct, "Source or Destination is not a struct")
+	}
+
+	return planOf(st, dt, TagName), nil
+}
+
+// Copy method copies the source `struct` into destination `struct` as per plan,
+// see `Copy()` method for the copy rules and options.
+func (p *Plan) Copy(dst, src interface{}, opts ...Option) []error {
+	if err := validateCopyInput(dst, src); err != nil {
+		return []error{err}
+	}
+
+	sv, dv := indirect(valueOf(src)), indirect(valueOf(dst))
+	if sv.Type() != p.srcType || dv.Type() != p.dstType {
+		return []error{newError(ErrTypeMismatch, "Plan is compiled for [%v] to [%v], not for [%v] to [%v]",
+			p.srcType, p.dstType, sv.Type(), dv.Type())}
+	}
+
+	// plan of tag name of the call
+	o := newOptions(opts)
+	if o.tagName() != TagName {
+		p = planOf(p.srcType, p.dstType, o.tagName())
+	}
+
+	errs := doCopyPlan(valueOf(dst), valueOf(src), p, newCopyState(o))
+	if len(errs) > 0 {
+		return o.limitErrors(errs)
+	}
+
+	return nil
+}
+
+// planOf method returns the cached plan for given types and tag name, plan gets
+// compiled if not exists.
+func planOf(st, dt reflect.Type, tagName string) *Plan {
+	key := planKey{src: st, dst: dt}
+	if tagName != TagName {
+		key.tag = tagName
+	}
+
+	if p, found := planCache.Load(key); found {
+		return p.(*Plan)
+	}
+
+	p := &Plan{srcType: st, dstType: dt}
+	ti := typeInfoOf(st)
+	tags := ti.tagsOf([]string{tagName})
+	for i, f := range ti.fields {
+		tag := tags[i]
+		if tag.isOmitField() {
+			continue
+		}
+
+		pf := planField{field: f, tag: tag}
+		pf.conv, _ = tag.option(Conv)
+		pf.timeFormat, _ = tag.timeLayout()
+		pf.appendTo = tag.isAppend()
+		pf.mergeTo = tag.isMerge()
+		if df, found := structField(dt, f.Name); found {
+			pf.dstIndex = df.Index
+			pf.dstOwner = fieldOwner(dt, df.Index)
+
+			dtag := newTag(df.Tag.Get(tagName))
+			if isStringEmpty(pf.conv) {
+				pf.conv, _ = dtag.option(Conv)
+			}
+			if isStringEmpty(pf.timeFormat) {
+				pf.timeFormat, _ = dtag.timeLayout()
+			}
+			pf.appendTo = pf.appendTo || dtag.isAppend()
+			pf.mergeTo = pf.mergeTo || dtag.isMerge()
+		}
+
+		p.fields = append(p.fields, pf)
+	}
+
+	actual, _ := planCache.LoadOrStore(key, p)
+	return actual.(*Plan)
+}
+
+// dstField method returns the destination field value, it's invalid if the
+// field does not exists or not reachable via nil embedded pointer. Nil embedded
+// pointer is allocated on demand if alloc is true.
+func (pf *planField) dstField(dv reflect.Value, alloc bool) reflect.Value {
+	if pf.dstIndex == nil {
+		return reflect.Value{}
+	}
+
+	dfv, err := dv.FieldByIndexErr(pf.dstIndex)
+	if err == nil {
+		return dfv
+	}
+
+	if !alloc {
+		return reflect.Value{}
+	}
+
+	dfv = dv
+	for i, x := range pf.dstIndex {
+		if i > 0 && isPtr(dfv) {
+			if dfv.IsNil() {
+				if !dfv.CanSet() {
+					return reflect.Value{}
+				}
+				dfv.Set(reflect.New(dfv.Type().Elem()))
+			}
+			dfv = dfv.Elem()
+		}
+		dfv = dfv.Field(x)
+	}
+
+	return dfv
+}
+
+// dstKey method returns the key of destination field, it's used to lookup
+// field converters and profile configuration.
+func (pf *planField) dstKey() fieldConverterKey {
+	return fieldConverterKey{typ: pf.dstOwner, name: pf.field.Name}
+}
+
+// converter method returns the converter of destination field from profile of
+// the call or registered one, otherwise named converter of "conv" option or
+// layout converter of "timefmt" option for the given destination type.
+func (pf *planField) converter(o *options, dt reflect.Type) (Converter, error) {
+	if pf.dstOwner != nil {
+		if o.profile != nil {
+			if c := o.profile.fieldConverter(pf.dstKey()); c != nil {
+				return c, nil
+			}
+		}
+
+		if c := fieldConverterOf(pf.dstOwner, pf.field.Name); c != nil {
+			return c, nil
+		}
+	}
+
+	if !isStringEmpty(pf.conv) {
+		return namedConverterOf(pf.conv)
+	}
+
+	if !isStringEmpty(pf.timeFormat) {
+		return timeFormatConverter(pf.timeFormat, pf.field.Type, dt), nil
+	}
+
+	return nil, nil
+}
+
+func structTypeOf(i interface{}) reflect.Type {
+	t := reflect.TypeOf(i)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	return t
+}
@@ -0,0 +1,136 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+type planSource struct {
+	Name    string
+	Count   int
+	Note    string `model:",omitempty"`
+	Secret  string `model:"-"`
+	Tags    []string
+	Address *SampleSubInfo
+}
+
+type planDestination struct {
+	Name    string
+	Count   int64
+	Note    string
+	Secret  string
+	Tags    []string
+	Address *SampleSubInfo
+}
+
+func TestCompilePlan(t *testing.T) {
+	plan, err := CompilePlan(planSource{}, &planDestination{})
+	assertError(t, err)
+
+	src := planSource{
+		Name:    "go-model",
+		Count:   10,
+		Secret:  "s3cr3t",
+		Tags:    []string{"a", "b"},
+		Address: &SampleSubInfo{Name: "Chennai"},
+	}
+
+	dst := planDestination{Note: "existing"}
+	errs := plan.Copy(&dst, &src)
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, "Field: 'Count', src [int] & dst [int64] kind didn't match", errs[0].Error())
+	assertEqual(t, "go-model", dst.Name)
+	assertEqual(t, "existing", dst.Note)
+	assertEqual(t, "", dst.Secret)
+	assertEqual(t, []string{"a", "b"}, dst.Tags)
+	assertEqual(t, "Chennai", dst.Address.Name)
+	assertEqual(t, false, dst.Address == src.Address)
+
+	// plan is cached and same as Copy
+	other, _ := CompilePlan(&planSource{}, planDestination{})
+	assertEqual(t, true, plan == other)
+
+	errs = plan.Copy(&src, &dst)
+	assertEqual(t, "Plan is compiled for [model.planSource] to [model.planDestination], not for [model.planDestination] to [model.planSource]", errs[0].Error())
+
+	errs = plan.Copy(dst, src)
+	assertEqual(t, "Destination struct is not a pointer", errs[0].Error())
+}
+
+func TestPlanCopyOptions(t *testing.T) {
+	type Source struct {
+		A, B, C int
+		note    string
+	}
+
+	type Destination struct {
+		A, B, C string
+		note    string
+	}
+
+	plan, _ := CompilePlan(Source{}, Destination{})
+	dst := Destination{}
+	errs := plan.Copy(&dst, Source{A: 1, B: 2, C: 3}, WithMaxErrors(1))
+	assertEqual(t, 2, len(errs))
+	assertEqual(t, true, errors.Is(errs[0], ErrTypeMismatch))
+	assertEqual(t, MoreErrors(1), errs[1])
+
+	errs = plan.Copy(&dst, &planSource{Name: "go-model"})
+	assertEqual(t, true, errors.Is(errs[0], ErrTypeMismatch))
+
+	// unexported fields of identical types
+	same, _ := CompilePlan(Source{}, Source{})
+	sdst := Source{}
+	errs = same.Copy(&sdst, Source{A: 1, note: "internal"}, WithUnexported())
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "internal", sdst.note)
+
+	// cycle back to root reuses the destination
+	root := &sampleNode{Name: "root"}
+	root.Children = []*sampleNode{{Name: "child", Parent: root}}
+
+	node, _ := CompilePlan(sampleNode{}, sampleNode{})
+	ndst := sampleNode{}
+	errs = node.Copy(&ndst, root)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, true, ndst.Children[0].Parent == &ndst)
+}
+
+func TestCompilePlanInvalidInput(t *testing.T) {
+	_, err := CompilePlan(nil, planDestination{})
+	assertEqual(t, "Source or Destination is nil", err.Error())
+
+	_, err = CompilePlan(planSource{}, "not a struct")
+	assertEqual(t, "Source or Destination is not a struct", err.Error())
+}
+
+func TestCompilePlanConcurrent(t *testing.T) {
+	plan, _ := CompilePlan(planSource{}, planDestination{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			dst := planDestination{}
+			plan.Copy(&dst, planSource{Name: "go-model"})
+			assertEqual(t, "go-model", dst.Name)
+		}()
+	}
+	wg.Wait()
+}
+
+func BenchmarkPlanCopy(b *testing.B) {
+	plan, _ := CompilePlan(planSource{}, planDestination{})
+	src := planSource{Name: "go-model", Tags: []string{"a", "b"}}
+
+	for i := 0; i < b.N; i++ {
+		dst := planDestination{}
+		plan.Copy(&dst, &src)
+	}
+}
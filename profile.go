@@ -0,0 +1,274 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Profile is the declarative mapping configuration of source and destination
+// `struct` type pair, for the mappings which tags alone can't express. Create it
+// using `NewProfile()` method and configure it before use; configured profile is
+// safe for concurrent use.
+type Profile struct {
+	srcType    reflect.Type
+	dstType    reflect.Type
+	fields     []*ProfileField
+	ignore     map[fieldConverterKey]bool
+	converters *Converters
+	err        error
+}
+
+// ProfileField is the mapping configuration of destination field in the `Profile`.
+type ProfileField struct {
+	profile   *Profile
+	path      string
+	key       fieldConverterKey
+	dstSegs   []pathSegment
+	from      string
+	srcSegs   []pathSegment
+	converter Converter
+}
+
+// NewProfile method creates the mapping profile for given source and destination
+// `struct` types, input can be either value or pointer.
+// 		Example:
+//
+// 		p := model.NewProfile(User{}, UserDto{})
+// 		p.Field("UserDto.Name").From("User.FullName")
+// 		p.Field("Address.Line").From("Addresses[0].Line1")
+// 		p.Field("CreatedAt").Convert(func(in reflect.Value) (reflect.Value, error) {
+// 			return reflect.ValueOf(in.Interface().(time.Time).Unix()), nil
+// 		})
+// 		p.Ignore("Internal", "Address.Zip")
+// 		p.Convert((*float64)(nil), (*string)(nil), priceConverter)
+//
+// 		errs := p.Copy(&userDto, user)
+//
+// Field path is the field path expression (see `Get()` method) which optionally starts
+// with the type name. Fields which are not configured are copied as per `Copy()`
+// method rules.
+//
+func NewProfile(src, dst interface{}) *Profile {
+	p := &Profile{ignore: map[fieldConverterKey]bool{}, converters: NewConverters()}
+	if src == nil || dst == nil {
+		p.err = newError(ErrNilInput, "Source or Destination is nil")
+		return p
+	}
+
+	p.srcType, p.dstType = structTypeOf(src), structTypeOf(dst)
+	if p.srcType == nil || p.dstType == nil {
+		p.err = newError(ErrNotStruct, "Source or Destination is not a struct")
+	}
+
+	return p
+}
+
+// Field method returns the mapping configuration of given destination field path.
+func (p *Profile) Field(path string) *ProfileField {
+	path = trimTypeName(path, p.dstType)
+	for _, pf := range p.fields {
+		if pf.path == path {
+			return pf
+		}
+	}
+
+	pf := &ProfileField{profile: p, path: path}
+	p.fields = append(p.fields, pf)
+	if p.err != nil {
+		return pf
+	}
+
+	var err error
+	if pf.dstSegs, err = parsePath(path); err != nil {
+		p.setErr(err)
+		return pf
+	}
+
+	// path with index/key is applicable only for "From" mapping
+	if strings.ContainsRune(path, '[') {
+		return pf
+	}
+
+	if pf.key, err = fieldConverterKeyOf(reflect.New(p.dstType).Interface(), path); err != nil {
+		p.setErr(err)
+	}
+
+	return pf
+}
+
+// Ignore method excludes the given destination field path(s) from copy. Ignored
+// field of nested struct is zero value, since nested struct is copied as a new value.
+func (p *Profile) Ignore(paths ...string) *Profile {
+	if p.err != nil {
+		return p
+	}
+
+	for _, path := range paths {
+		key, err := fieldConverterKeyOf(reflect.New(p.dstType).Interface(), trimTypeName(path, p.dstType))
+		if err != nil {
+			p.setErr(err)
+			return p
+		}
+		p.ignore[key] = true
+	}
+
+	return p
+}
+
+// Convert method registers the custom `Converter` for the datatype pair within
+// the profile by supplying pointers of the target types, see `WithConverters()`.
+func (p *Profile) Convert(in interface{}, out interface{}, converter Converter) *Profile {
+	p.converters.Add(in, out, converter)
+	return p
+}
+
+// Copy method copies the source `struct` into destination `struct` as per profile,
+// see `Copy()` method for the copy rules and options.
+func (p *Profile) Copy(dst, src interface{}, opts ...Option) []error {
+	if p.err != nil {
+		return []error{p.err}
+	}
+
+	if err := validateCopyInput(dst, src); err != nil {
+		return []error{err}
+	}
+
+	sv, dv := indirect(valueOf(src)), indirect(valueOf(dst))
+	if sv.Type() != p.srcType || dv.Type() != p.dstType {
+		return []error{newError(ErrTypeMismatch, "Profile is configured for [%v] to [%v], not for [%v] to [%v]",
+			p.srcType, p.dstType, sv.Type(), dv.Type())}
+	}
+
+	o := newOptions(append([]Option{WithConverters(p.converters)}, opts...))
+	o.profile = p
+	cs := newCopyState(o)
+
+	errs := doCopy(dv, sv, cs)
+	for _, pf := range p.fields {
+		if len(pf.srcSegs) > 0 {
+			errs = append(errs, pf.copyFrom(dv, sv, cs)...)
+		}
+	}
+
+	if len(errs) > 0 {
+		return o.limitErrors(errs)
+	}
+
+	return nil
+}
+
+// From method maps the destination field from given source field path.
+func (pf *ProfileField) From(path string) *ProfileField {
+	pf.from = trimTypeName(path, pf.profile.srcType)
+	segs, err := parsePath(pf.from)
+	if err != nil {
+		pf.profile.setErr(err)
+		return pf
+	}
+
+	pf.srcSegs = segs
+	return pf
+}
+
+// Convert method sets the `Converter` of destination field, it takes precedence
+// over the type converters.
+func (pf *ProfileField) Convert(converter Converter) *ProfileField {
+	pf.converter = converter
+	return pf
+}
+
+// copyFrom method copies the source field path value into destination field path.
+func (pf *ProfileField) copyFrom(dv, sv reflect.Value, cs *copyState) []error {
+	val, err := getPath(sv, pf.srcSegs)
+	if err == errPathNilValue {
+		val = reflect.Value{}
+	} else if err != nil {
+		return []error{newFieldError(pf.from, nil, nil, err, "%v", err)}
+	}
+
+	var errs []error
+	err = setPath(dv, pf.dstSegs, val, "", func(fv, val reflect.Value) error {
+		if !fv.CanSet() {
+			return errPathNotSettable
+		}
+
+		if !val.IsValid() || isFieldZero(val) {
+			fv.Set(reflect.Zero(fv.Type()))
+			return nil
+		}
+
+		if pf.converter != nil {
+			return convertField(fv, val, pf.path, pf.converter)
+		}
+
+		v, innerErrs := copyVal(fv.Type(), val, cs.opts.isNoTraverseType(val), cs)
+		errs = append(errs, innerErrs...)
+		if !v.IsValid() {
+			return nil
+		}
+
+		if !v.Type().AssignableTo(fv.Type()) {
+			return newFieldError(pf.path, v.Type(), fv.Type(), ErrTypeMismatch,
+				"src [%v] & dst [%v] type didn't match", v.Type(), fv.Type())
+		}
+
+		fv.Set(v)
+		return nil
+	})
+
+	if err != nil {
+		if !strings.HasPrefix(err.Error(), "Field: ") {
+			err = fmt.Errorf("Field: '%v', %w", pf.path, err)
+		}
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+func (p *Profile) setErr(err error) {
+	if p.err == nil {
+		p.err = err
+	}
+}
+
+// skipField method reports the destination field is ignored or mapped
+// from another source field in the profile.
+func (p *Profile) skipField(key fieldConverterKey) bool {
+	if p.ignore[key] {
+		return true
+	}
+
+	for _, pf := range p.fields {
+		if pf.key == key && len(pf.srcSegs) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fieldConverter method returns the converter of destination field.
+func (p *Profile) fieldConverter(key fieldConverterKey) Converter {
+	for _, pf := range p.fields {
+		if pf.key == key && len(pf.srcSegs) == 0 {
+			return pf.converter
+		}
+	}
+
+	return nil
+}
+
+// trimTypeName method removes the leading type name from field path.
+func trimTypeName(path string, t reflect.Type) string {
+	if t != nil && strings.HasPrefix(path, t.Name()+".") {
+		return path[len(t.Name())+1:]
+	}
+
+	return path
+}
@@ -0,0 +1,116 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+type profileAddress struct {
+	Line1 string
+	City  string
+	Zip   string
+}
+
+type profileUser struct {
+	FullName  string
+	Email     string
+	Internal  string
+	Balance   float64
+	CreatedAt time.Time
+	Addresses []profileAddress
+	Home      profileAddress
+}
+
+type profileAddressDto struct {
+	Line string
+	City string
+	Zip  string
+}
+
+type profileUserDto struct {
+	Name      string
+	Email     string
+	Internal  string
+	Balance   string
+	CreatedAt int64
+	Address   *profileAddressDto
+	Home      profileAddress
+}
+
+func TestProfileCopy(t *testing.T) {
+	p := NewProfile(profileUser{}, &profileUserDto{})
+	p.Field("profileUserDto.Name").From("profileUser.FullName")
+	p.Field("Address.Line").From("Addresses[0].Line1")
+	p.Field("CreatedAt").Convert(func(in reflect.Value) (reflect.Value, error) {
+		return reflect.ValueOf(in.Interface().(time.Time).Unix()), nil
+	})
+	p.Ignore("Internal", "Home.Zip").
+		Convert((*float64)(nil), (*string)(nil), func(in reflect.Value) (reflect.Value, error) {
+			return reflect.ValueOf(strconv.FormatFloat(in.Float(), 'f', 2, 64)), nil
+		})
+
+	now := time.Date(2016, 8, 1, 10, 0, 0, 0, time.UTC)
+	src := profileUser{
+		FullName:  "Jeeva",
+		Email:     "jeeva@myjeeva.com",
+		Internal:  "secret",
+		Balance:   10.5,
+		CreatedAt: now,
+		Addresses: []profileAddress{{Line1: "1st Street", City: "Chennai"}},
+		Home:      profileAddress{Line1: "2nd Street", City: "Chennai", Zip: "600001"},
+	}
+
+	dst := profileUserDto{Internal: "keep", Home: profileAddress{Zip: "keep"}}
+	errs := p.Copy(&dst, src)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "Jeeva", dst.Name)
+	assertEqual(t, "jeeva@myjeeva.com", dst.Email)
+	assertEqual(t, "keep", dst.Internal)
+	assertEqual(t, "10.50", dst.Balance)
+	assertEqual(t, now.Unix(), dst.CreatedAt)
+	assertEqual(t, "1st Street", dst.Address.Line)
+	assertEqual(t, "", dst.Address.City)
+	assertEqual(t, "2nd Street", dst.Home.Line1)
+	assertEqual(t, "", dst.Home.Zip)
+
+	// profile converter doesn't affect other calls
+	errs = Copy(&profileUserDto{}, src)
+	assertEqual(t, "Field: 'Balance', src [float64] & dst [string] kind didn't match", errs[0].Error())
+
+	// source path error
+	errs = p.Copy(&profileUserDto{}, profileUser{FullName: "Jeeva"})
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, "Field: 'Addresses[0].Line1', index '0' out of range", errs[0].Error())
+
+	// type mismatch
+	errs = p.Copy(&profileAddressDto{}, profileAddress{City: "Chennai"})
+	assertEqual(t, "Profile is configured for [model.profileUser] to [model.profileUserDto], not for [model.profileAddress] to [model.profileAddressDto]", errs[0].Error())
+}
+
+func TestProfileConfigError(t *testing.T) {
+	p := NewProfile(profileUser{}, profileUserDto{})
+	p.Field("NotExists").From("FullName")
+	errs := p.Copy(&profileUserDto{}, profileUser{FullName: "Jeeva"})
+	assertEqual(t, "Field: 'NotExists', does not exists", errs[0].Error())
+
+	p = NewProfile(profileUser{}, profileUserDto{})
+	p.Field("Name").From("Full..Name")
+	errs = p.Copy(&profileUserDto{}, profileUser{FullName: "Jeeva"})
+	assertEqual(t, "invalid path 'Full..Name'", errs[0].Error())
+
+	p = NewProfile(profileUser{}, profileUserDto{}).Ignore("Unknown")
+	errs = p.Copy(&profileUserDto{}, profileUser{FullName: "Jeeva"})
+	assertEqual(t, "Field: 'Unknown', does not exists", errs[0].Error())
+
+	errs = NewProfile(nil, profileUserDto{}).Copy(&profileUserDto{}, profileUser{})
+	assertEqual(t, "Source or Destination is nil", errs[0].Error())
+
+	errs = NewProfile("", profileUserDto{}).Copy(&profileUserDto{}, profileUser{})
+	assertEqual(t, "Source or Destination is not a struct", errs[0].Error())
+}
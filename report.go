@@ -0,0 +1,108 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import "fmt"
+
+// CopyReport is the per-field statistics of the copy run, it's populated by
+// `Copy()` and `Clone()` methods when supplied via `WithReport()` option. Field
+// paths are the path expression of source field (see `Get()` method), slice,
+// array and map elements are indexed, for eg.: "Items[0].Name". It's serializable
+// to JSON.
+type CopyReport struct {
+	// Copied is the field paths copied into destination as-is
+	Copied []string `json:"copied"`
+
+	// Converted is the field paths copied into destination with conversion
+	Converted []string `json:"converted"`
+
+	// Skipped is the field paths skipped by 'omitempty', 'omitzero' options or
+	// nil source pointer, see `WithSkipNilPointers()`
+	Skipped []string `json:"skipped"`
+
+	// Failed is the field paths which are not copied due to error
+	Failed []string `json:"failed"`
+}
+
+// WithReport option populates the given `CopyReport` with copied, converted,
+// skipped and failed field paths. Nested struct fields are reported individually
+// and embedded struct fields are reported at same level as represented by Go.
+// 		Example:
+//
+// 		report := &model.CopyReport{}
+// 		errs := model.Copy(&dst, src, model.WithReport(report))
+//
+// 		fmt.Println("Skipped:", report.Skipped)
+// 		fmt.Println("Failed:", report.Failed)
+//
+func WithReport(r *CopyReport) Option {
+	return func(o *options) {
+		o.report = r
+	}
+}
+
+type reportKind uint8
+
+const (
+	reportCopied reportKind = iota
+	reportConverted
+	reportSkipped
+	reportFailed
+)
+
+// record method adds the field path into report of the copy, if it's enabled.
+func (cs *copyState) record(kind reportKind, path string) {
+	r := cs.opts.report
+	if r == nil {
+		return
+	}
+
+	switch kind {
+	case reportCopied:
+		r.Copied = append(r.Copied, path)
+	case reportConverted:
+		r.Converted = append(r.Converted, path)
+	case reportSkipped:
+		r.Skipped = append(r.Skipped, path)
+	case reportFailed:
+		r.Failed = append(r.Failed, path)
+	}
+}
+
+// fieldPath method returns the path of field in copy process, it's computed
+// only if the path is traced.
+func (cs *copyState) fieldPath(name string) string {
+	if !cs.trace() {
+		return ""
+	}
+
+	return joinPath(cs.path, name)
+}
+
+// enter method switches the current path of copy state, returned func restores
+// the previous one.
+func (cs *copyState) enter(path string) func() {
+	if !cs.trace() {
+		return func() {}
+	}
+
+	prev := cs.path
+	cs.path = path
+	return func() { cs.path = prev }
+}
+
+// enterIndex method switches the current path to element of slice, array or map.
+func (cs *copyState) enterIndex(i interface{}) func() {
+	if !cs.trace() {
+		return func() {}
+	}
+
+	return cs.enter(fmt.Sprintf("%v[%v]", cs.path, i))
+}
+
+// trace method reports the field path is traced in copy process.
+func (cs *copyState) trace() bool {
+	return cs.opts.report != nil || cs.opts.fieldHook != nil
+}
@@ -0,0 +1,94 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+type SampleReportBase struct {
+	ID int
+}
+
+func TestCopyReport(t *testing.T) {
+	type Item struct {
+		Name  string
+		Price int
+	}
+
+	type Src struct {
+		SampleReportBase
+		Name   string
+		Note   string `model:",omitempty"`
+		Count  int32
+		Status string
+		Items  []Item
+	}
+
+	type Dst struct {
+		SampleReportBase
+		Name   string
+		Note   string
+		Count  int64
+		Status int
+		Items  []Item
+	}
+
+	src := Src{
+		SampleReportBase: SampleReportBase{ID: 7},
+		Name:             "go-model",
+		Count:            3,
+		Status:           "active",
+		Items:            []Item{{Name: "pen", Price: 10}},
+	}
+
+	report := &CopyReport{}
+	var dst Dst
+	errs := Copy(&dst, src, WithNumericConvert(), WithReport(report))
+
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, []string{"ID", "Name", "Items[0].Name", "Items[0].Price", "Items"}, report.Copied)
+	assertEqual(t, []string{"Count"}, report.Converted)
+	assertEqual(t, []string{"Note"}, report.Skipped)
+	assertEqual(t, []string{"Status"}, report.Failed)
+
+	assertEqual(t, 7, dst.ID)
+	assertEqual(t, int64(3), dst.Count)
+	assertEqual(t, "pen", dst.Items[0].Name)
+
+	b, err := json.Marshal(report)
+	assertError(t, err)
+	assertEqual(t, true, strings.Contains(string(b), `"failed":["Status"]`))
+}
+
+func TestCopyReportNested(t *testing.T) {
+	type Address struct {
+		City string
+		Zip  string `model:",omitempty"`
+	}
+
+	type User struct {
+		Name    string
+		Address Address
+		Tags    map[string]Address
+	}
+
+	src := User{
+		Name:    "Jeeva",
+		Address: Address{City: "Chennai"},
+		Tags:    map[string]Address{"home": {City: "Madurai"}},
+	}
+
+	report := &CopyReport{}
+	var dst User
+	errs := Copy(&dst, src, WithReport(report))
+
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, []string{"Name", "Address.City", "Tags[home].City", "Tags"}, report.Copied)
+	assertEqual(t, []string{"Address.Zip", "Tags[home].Zip"}, report.Skipped)
+	assertEqual(t, "Madurai", dst.Tags["home"].City)
+}
@@ -0,0 +1,200 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import "reflect"
+
+// CopySlice method copies the source slice into destination slice pointer, the
+// elements are copied with the same rules of slice field in `Copy()` method. So
+// `[]Src` can be copied into `[]Dst` without wrapping them in a struct.
+// 		Example:
+//
+// 		var products []Product
+// 		errs := model.CopySlice(&products, productDtos)
+// 		if errs != nil {
+// 			fmt.Println("Errors:", errs)
+// 		}
+//
+// Source can be either slice or array, nil source slice is copied as nil.
+//
+func CopySlice(dst, src interface{}, opts ...Option) []error {
+	if src == nil || dst == nil {
+		return []error{newError(ErrNilInput, "Source or Destination is nil")}
+	}
+
+	dv := valueOf(dst)
+	if !isPtr(dv) || dv.IsNil() || dv.Elem().Kind() != reflect.Slice {
+		return []error{newError(ErrNotPointer, "Destination is not a pointer of slice")}
+	}
+	dv = dv.Elem()
+
+	sv := indirect(valueOf(src))
+	if sv.Kind() != reflect.Slice && sv.Kind() != reflect.Array {
+		return []error{newError(ErrTypeMismatch, "Source is not a slice")}
+	}
+
+	if sv.Kind() == reflect.Slice && sv.IsNil() {
+		dv.Set(reflect.Zero(dv.Type()))
+		return nil
+	}
+
+	o := newOptions(opts)
+	v, errs := copySlice(dv.Type(), sv, newCopyState(o))
+	dv.Set(v)
+
+	if len(errs) > 0 {
+		return o.limitErrors(errs)
+	}
+
+	return nil
+}
+
+// ConvertSlice function is the generic form of `CopySlice()` method, it returns
+// the new slice of type D.
+// 		Example:
+//
+// 		products, errs := model.ConvertSlice[Product](productDtos)
+//
+func ConvertSlice[D, S any](src []S, opts ...Option) ([]D, []error) {
+	var dst []D
+	if src == nil {
+		return dst, nil
+	}
+
+	errs := CopySlice(&dst, src, opts...)
+	return dst, errs
+}
+
+// copySlice method copies the source slice or array elements into new slice of
+// given type.
+func copySlice(dt reflect.Type, sv reflect.Value, cs *copyState) (reflect.Value, []error) {
+	if sv.Kind() == reflect.Slice {
+		return copyVal(dt, sv, false, cs)
+	}
+
+	var errs []error
+	nv := reflect.MakeSlice(dt, sv.Len(), sv.Len())
+	for i := 0; i < sv.Len(); i++ {
+		ov := sv.Index(i)
+
+		v, err := copyVal(dt.Elem(), ov, cs.opts.isNoTraverseType(ov), cs)
+		errs = append(errs, elemErrors(i, ov.Type(), dt.Elem(), err)...)
+
+		// failed conversion doesn't produce a value
+		if v.IsValid() {
+			nv.Index(i).Set(v)
+		}
+	}
+
+	return nv, errs
+}
+
+// isMapInput method reports the input is a map or pointer of map.
+func isMapInput(i interface{}) bool {
+	return indirect(valueOf(i)).Kind() == reflect.Map
+}
+
+// copyRootMap method copies the source map into destination map pointer, the
+// elements are copied with the same rules of map field in `Copy()` method.
+func copyRootMap(dst, src interface{}, o *options) []error {
+	if src == nil || dst == nil {
+		return []error{newError(ErrNilInput, "Source or Destination is nil")}
+	}
+
+	dv := valueOf(dst)
+	if !isPtr(dv) || dv.IsNil() || dv.Elem().Kind() != reflect.Map {
+		return []error{newError(ErrNotPointer, "Destination is not a pointer of map")}
+	}
+	dv = dv.Elem()
+
+	sv := indirect(valueOf(src))
+	if sv.Kind() != reflect.Map {
+		return []error{newError(ErrTypeMismatch, "Source is not a map")}
+	}
+
+	if sv.Type().Key() != dv.Type().Key() {
+		return []error{newError(ErrTypeMismatch, "src [%v] & dst [%v] key type didn't match",
+			sv.Type().Key(), dv.Type().Key())}
+	}
+
+	if sv.IsNil() {
+		dv.Set(reflect.Zero(dv.Type()))
+		return nil
+	}
+
+	v, errs := copyVal(dv.Type(), sv, false, newCopyState(o))
+	dv.Set(v)
+
+	if len(errs) > 0 {
+		return o.limitErrors(errs)
+	}
+
+	return nil
+}
+
+// cloneRootMap method creates deep copy of the source map.
+func cloneRootMap(s interface{}, o *options) interface{} {
+	sv := indirect(valueOf(s))
+	if sv.IsNil() {
+		return sv.Interface()
+	}
+
+	v, _ := copyVal(sv.Type(), sv, false, newCopyState(o))
+	return v.Interface()
+}
+
+// MapSlice method converts the given slice of `struct` into `[]map[string]interface{}`,
+// each element is converted same as `Map()` method. Nil pointer element is nil map.
+// 		Example:
+//
+// 		products := []Product{ /* product values go here */ }
+//
+// 		items, err := model.MapSlice(products)
+// 		if err != nil {
+// 			fmt.Println("Error:", err)
+// 		}
+//
+func MapSlice(s interface{}, opts ...Option) ([]map[string]interface{}, error) {
+	if s == nil {
+		return nil, newError(ErrNilInput, "Invalid input <nil>")
+	}
+
+	sv := indirect(valueOf(s))
+	if sv.Kind() != reflect.Slice && sv.Kind() != reflect.Array {
+		return nil, newError(ErrTypeMismatch, "Input is not a slice")
+	}
+
+	if sv.Kind() == reflect.Slice && sv.IsNil() {
+		return nil, nil
+	}
+
+	o := newOptions(opts)
+	result := make([]map[string]interface{}, sv.Len())
+	for i := 0; i < sv.Len(); i++ {
+		ev := sv.Index(i)
+		if isInterface(ev) || isPtr(ev) {
+			if ev.IsNil() {
+				continue
+			}
+			o.mapping = newMapState(valueOf(ev.Interface()))
+			ev = structOf(ev)
+		} else {
+			o.mapping = newMapState(ev)
+		}
+
+		if ev.Kind() != reflect.Struct {
+			return nil, newError(ErrNotStruct, "Element: '%d', is not a struct", i)
+		}
+
+		result[i] = doMap(ev, o)
+
+		// reference cycle cannot be represented in map
+		if o.mapping.failed() {
+			return nil, o.mapping.err
+		}
+	}
+
+	return result, nil
+}
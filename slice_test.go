@@ -0,0 +1,169 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import "testing"
+
+type sliceProductDto struct {
+	Name  string
+	Price float64
+	Tags  []string
+}
+
+type sliceProduct struct {
+	Name  string
+	Price float64
+	Tags  []string
+	Stock int
+}
+
+func TestCopySlice(t *testing.T) {
+	src := []sliceProductDto{
+		{Name: "go-model", Price: 10.5, Tags: []string{"go"}},
+		{Name: "aah", Price: 20},
+	}
+
+	var dst []sliceProduct
+	errs := CopySlice(&dst, src)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, 2, len(dst))
+	assertEqual(t, "go-model", dst[0].Name)
+	assertEqual(t, float64(10.5), dst[0].Price)
+	assertEqual(t, []string{"go"}, dst[0].Tags)
+	assertEqual(t, "aah", dst[1].Name)
+
+	// deep copied
+	src[0].Tags[0] = "modified"
+	assertEqual(t, "go", dst[0].Tags[0])
+
+	// pointer elements
+	var pdst []*sliceProduct
+	errs = CopySlice(&pdst, []*sliceProductDto{{Name: "go-model"}, nil})
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "go-model", pdst[0].Name)
+	assertEqual(t, true, pdst[1] == nil)
+
+	// array source
+	var names []string
+	errs = CopySlice(&names, [2]string{"a", "b"})
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, []string{"a", "b"}, names)
+
+	// options are applied
+	var counts []int64
+	errs = CopySlice(&counts, []int32{1, 2}, WithNumericConvert())
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, []int64{1, 2}, counts)
+
+	// nil source
+	errs = CopySlice(&dst, []sliceProductDto(nil))
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, true, dst == nil)
+
+	// invalid input
+	errs = CopySlice(nil, src)
+	assertEqual(t, "Source or Destination is nil", errs[0].Error())
+
+	errs = CopySlice(dst, src)
+	assertEqual(t, "Destination is not a pointer of slice", errs[0].Error())
+
+	errs = CopySlice(&dst, src[0])
+	assertEqual(t, "Source is not a slice", errs[0].Error())
+}
+
+func TestConvertSlice(t *testing.T) {
+	dst, errs := ConvertSlice[sliceProduct]([]sliceProductDto{{Name: "go-model"}})
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "go-model", dst[0].Name)
+
+	dst, errs = ConvertSlice[sliceProduct]([]sliceProductDto(nil))
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, true, dst == nil)
+}
+
+func TestCopyRootMap(t *testing.T) {
+	src := map[string]sliceProductDto{
+		"go-model": {Name: "go-model", Price: 10.5, Tags: []string{"go"}},
+		"aah":      {Name: "aah"},
+	}
+
+	var dst map[string]sliceProduct
+	errs := Copy(&dst, src)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, 2, len(dst))
+	assertEqual(t, "go-model", dst["go-model"].Name)
+	assertEqual(t, []string{"go"}, dst["go-model"].Tags)
+
+	// deep copied
+	src["go-model"].Tags[0] = "modified"
+	assertEqual(t, "go", dst["go-model"].Tags[0])
+
+	// element converter
+	var counts map[string]int64
+	errs = Copy(&counts, &map[string]int32{"daily": 10}, WithNumericConvert())
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, map[string]int64{"daily": 10}, counts)
+
+	// nil source
+	errs = Copy(&dst, map[string]sliceProductDto(nil))
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, true, dst == nil)
+
+	// invalid input
+	errs = Copy(dst, src)
+	assertEqual(t, "Destination is not a pointer of map", errs[0].Error())
+
+	errs = Copy(&dst, sliceProductDto{Name: "go-model"})
+	assertEqual(t, "Source is not a map", errs[0].Error())
+
+	errs = Copy(&map[int]sliceProduct{}, src)
+	assertEqual(t, "src [string] & dst [int] key type didn't match", errs[0].Error())
+}
+
+func TestCloneRootMap(t *testing.T) {
+	src := map[string]*sliceProduct{"go-model": {Name: "go-model", Tags: []string{"go"}}}
+
+	result, err := Clone(src)
+	assertError(t, err)
+
+	dst := result.(map[string]*sliceProduct)
+	assertEqual(t, "go-model", dst["go-model"].Name)
+	assertEqual(t, false, dst["go-model"] == src["go-model"])
+
+	result, err = Clone(map[string]int(nil))
+	assertError(t, err)
+	assertEqual(t, true, result.(map[string]int) == nil)
+}
+
+func TestMapSlice(t *testing.T) {
+	items, err := MapSlice([]sliceProduct{{Name: "go-model", Price: 10.5}, {Name: "aah"}})
+	assertError(t, err)
+	assertEqual(t, 2, len(items))
+	assertEqual(t, "go-model", items[0]["Name"])
+	assertEqual(t, float64(10.5), items[0]["Price"])
+	assertEqual(t, "aah", items[1]["Name"])
+
+	items, err = MapSlice(&[]*sliceProduct{{Name: "go-model"}, nil}, WithFieldMask("Name"))
+	assertError(t, err)
+	assertEqual(t, map[string]interface{}{"Name": "go-model"}, items[0])
+	assertEqual(t, true, items[1] == nil)
+
+	items, err = MapSlice([]interface{}{sliceProduct{Name: "go-model"}})
+	assertError(t, err)
+	assertEqual(t, "go-model", items[0]["Name"])
+
+	items, err = MapSlice([]sliceProduct(nil))
+	assertError(t, err)
+	assertEqual(t, true, items == nil)
+
+	_, err = MapSlice(nil)
+	assertEqual(t, "Invalid input <nil>", err.Error())
+
+	_, err = MapSlice(sliceProduct{})
+	assertEqual(t, "Input is not a slice", err.Error())
+
+	_, err = MapSlice([]interface{}{sliceProduct{}, "go-model"})
+	assertEqual(t, "Element: '1', is not a struct", err.Error())
+}
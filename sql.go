@@ -0,0 +1,105 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"reflect"
+)
+
+var (
+	typeOfValuer  = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+	typeOfScanner = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+)
+
+// isValuer method reports the type implements `driver.Valuer`.
+func isValuer(t reflect.Type) bool {
+	return t.Implements(typeOfValuer)
+}
+
+// isScanner method reports the pointer of type implements `sql.Scanner`, pointer
+// type is considered by it's element.
+func isScanner(t reflect.Type) bool {
+	return reflect.PtrTo(indirectType(t)).Implements(typeOfScanner)
+}
+
+// isSQLConvertible method reports the source type is copied into destination type
+// via `driver.Valuer` of source or `sql.Scanner` of destination, for eg.:
+// `sql.NullString` and `string`. Identical types and interface destination are
+// copied as usual.
+func isSQLConvertible(st, dt reflect.Type) bool {
+	if st == dt || indirectType(st) == indirectType(dt) || isInterfaceType(dt) {
+		return false
+	}
+
+	return isValuer(st) || isScanner(dt)
+}
+
+// sqlConvert method copies the source value into destination type, driver value
+// of source is used if it implements `driver.Valuer`. Destination which implements
+// `sql.Scanner` scans the value, otherwise driver value is assigned or converted.
+func sqlConvert(f reflect.Value, dt reflect.Type) (reflect.Value, error) {
+	var src interface{}
+	if isValuer(f.Type()) {
+		if isPtr(f) && f.IsNil() {
+			return reflect.Zero(dt), nil
+		}
+
+		v, err := f.Interface().(driver.Valuer).Value()
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		src = v
+	} else {
+		if isPtr(f) {
+			if f.IsNil() {
+				return reflect.Zero(dt), nil
+			}
+			f = f.Elem()
+		}
+		src = f.Interface()
+	}
+
+	if isScanner(dt) {
+		nv := reflect.New(indirectType(dt))
+		if err := nv.Interface().(sql.Scanner).Scan(src); err != nil {
+			return reflect.Value{}, err
+		}
+
+		if dt.Kind() == reflect.Ptr {
+			return nv, nil
+		}
+		return nv.Elem(), nil
+	}
+
+	if src == nil {
+		return reflect.Zero(dt), nil
+	}
+
+	v := valueOf(src)
+	et := indirectType(dt)
+	switch {
+	case v.Type().AssignableTo(et):
+	case isNumberKind(v.Kind()) && isNumberKind(et.Kind()):
+		nv, err := convertNumber(v, et)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v = nv
+	case v.Type().ConvertibleTo(et) && v.Kind() == et.Kind():
+		v = v.Convert(et)
+	default:
+		return reflect.Value{}, newError(ErrTypeMismatch, "driver value [%v] is not assignable to [%v]", v.Type(), dt)
+	}
+
+	if dt.Kind() == reflect.Ptr {
+		pv := reflect.New(et)
+		pv.Elem().Set(v)
+		return pv, nil
+	}
+
+	return v, nil
+}
@@ -0,0 +1,133 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+type sampleSQLStatus int
+
+func (s sampleSQLStatus) Value() (driver.Value, error) {
+	switch s {
+	case 1:
+		return "active", nil
+	case 2:
+		return "closed", nil
+	}
+
+	return nil, fmt.Errorf("invalid status %d", s)
+}
+
+func (s *sampleSQLStatus) Scan(src interface{}) error {
+	switch src {
+	case "active":
+		*s = 1
+	case "closed":
+		*s = 2
+	default:
+		return fmt.Errorf("invalid status %v", src)
+	}
+
+	return nil
+}
+
+func TestCopySQLValuer(t *testing.T) {
+	type Row struct {
+		Name      sql.NullString
+		Nickname  sql.NullString
+		Age       sql.NullInt64
+		Score     sql.NullFloat64
+		Status    sampleSQLStatus
+		CreatedAt sql.NullTime
+		Notes     *sql.NullString
+		Any       interface{}
+	}
+
+	type Dto struct {
+		Name      string
+		Nickname  *string
+		Age       int
+		Score     float32
+		Status    string
+		CreatedAt time.Time
+		Notes     string
+		Any       interface{}
+	}
+
+	createdAt := time.Date(2017, 1, 2, 15, 4, 5, 0, time.UTC)
+	src := Row{
+		Name:      sql.NullString{String: "jeeva", Valid: true},
+		Nickname:  sql.NullString{String: "jm", Valid: true},
+		Age:       sql.NullInt64{Int64: 30, Valid: true},
+		Score:     sql.NullFloat64{Float64: 9.5, Valid: true},
+		Status:    1,
+		CreatedAt: sql.NullTime{Time: createdAt, Valid: true},
+		Any:       sql.NullString{String: "any", Valid: true},
+	}
+
+	var dst Dto
+	errs := Copy(&dst, src)
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, "jeeva", dst.Name)
+	assertEqual(t, "jm", *dst.Nickname)
+	assertEqual(t, 30, dst.Age)
+	assertEqual(t, float32(9.5), dst.Score)
+	assertEqual(t, "active", dst.Status)
+	assertEqual(t, true, dst.CreatedAt.Equal(createdAt))
+	assertEqual(t, "", dst.Notes)
+	assertEqual(t, true, dst.Any.(sql.NullString) == src.Any)
+
+	// null value is zero value
+	dst = Dto{Name: "jeeva"}
+	errs = Copy(&dst, Row{Status: 2})
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, "", dst.Name)
+	assertEqual(t, "closed", dst.Status)
+
+	errs = Copy(&dst, Row{Status: 3})
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, "Field: 'Status', invalid status 3", errs[0].Error())
+}
+
+func TestCopySQLScanner(t *testing.T) {
+	type Dto struct {
+		Name   string
+		Age    int64
+		Status string
+		Notes  *string
+	}
+
+	type Row struct {
+		Name   sql.NullString
+		Age    sql.NullInt64
+		Status sampleSQLStatus
+		Notes  *sql.NullString
+	}
+
+	notes := "none"
+	src := Dto{Name: "jeeva", Age: 30, Status: "closed", Notes: &notes}
+
+	var dst Row
+	errs := Copy(&dst, src)
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, true, dst.Name == sql.NullString{String: "jeeva", Valid: true})
+	assertEqual(t, true, dst.Age == sql.NullInt64{Int64: 30, Valid: true})
+	assertEqual(t, true, dst.Status == 2)
+	assertEqual(t, true, *dst.Notes == sql.NullString{String: "none", Valid: true})
+
+	errs = Copy(&dst, Dto{Name: "jeeva", Status: "open"})
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, true, strings.HasPrefix(errs[0].Error(), "Field: 'Status', invalid status open"))
+
+	var fe *FieldError
+	assertEqual(t, true, errors.As(errs[0], &fe))
+}
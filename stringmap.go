@@ -0,0 +1,175 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"reflect"
+	"strconv"
+	"time"
+)
+
+var (
+	typeOfString   = reflect.TypeOf("")
+	typeOfTime     = reflect.TypeOf(time.Time{})
+	typeOfDuration = reflect.TypeOf(time.Duration(0))
+)
+
+// FromStringMap method populates the exported fields of destination `struct`
+// from the given `map[string]string`. The string values are parsed into the field
+// type; supported types are string, bool, int*, uint*, float*, `time.Duration`
+// (`time.ParseDuration` format), `time.Time` (RFC3339 format) and pointer of these.
+// Custom `Converter` registered from `string` to field type takes precedence.
+// 		Example:
+//
+// 		labels := map[string]string{"bookCount": "100", "ttl": "30s"}
+//
+// 		dst := SampleStruct{}
+// 		errs := model.FromStringMap(&dst, labels)
+// 		if errs != nil {
+// 			fmt.Println("Errors:", errs)
+// 		}
+//
+// The key name lookup follows the same rule of `Map()` method, field name or
+// "model" tag name. Embedded struct fields are looked up at same level as
+// represented by Go. Map keys without a matching field are ignored.
+//
+// A "model" tag with the value of "-" is ignored by library for processing.
+//
+// FromStringMap method accepts the `Option`(s), for eg.: `WithTagName()` to look
+// up the key names of custom tag, `WithConverters()` to parse the string value
+// with converters scoped to the call.
+//
+func FromStringMap(dst interface{}, m map[string]string, opts ...Option) []error {
+	dv, err := destStructValue(dst)
+	if err != nil {
+		return []error{err}
+	}
+
+	errs := doFromStringMap(dv, m, newOptions(opts))
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+func doFromStringMap(dv reflect.Value, m map[string]string, o *options) []error {
+	var errs []error
+
+	for _, f := range modelFields(dv) {
+		fv := dv.FieldByName(f.Name)
+		tag := o.tagOf(f)
+
+		if tag.isOmitField() {
+			continue
+		}
+
+		// embedded struct fields are looked up at embedded level
+		if f.Anonymous && isStruct(fv) && !o.isNoTraverseType(fv) {
+			if isPtr(fv) && fv.IsNil() {
+				continue
+			}
+
+			errs = append(errs, doFromStringMap(indirect(fv), m, o)...)
+			continue
+		}
+
+		str, found := m[tag.keyName(f)]
+		if !found || !fv.CanSet() {
+			continue
+		}
+
+		v, err := o.parseString(str, fv.Type())
+		if err != nil {
+			errs = append(errs, valueError(f.Name, valueOf(str), fv.Type(), err))
+			continue
+		}
+
+		fv.Set(v)
+	}
+
+	return errs
+}
+
+// parseString method parses the given string into value of given type.
+func parseString(str string, t reflect.Type) (reflect.Value, error) {
+	return newOptions(nil).parseString(str, t)
+}
+
+// parseString method is same as `parseString` and applies the scoped converters
+// of the call too.
+func (o *options) parseString(str string, t reflect.Type) (reflect.Value, error) {
+	if o.conversionExists(typeOfString, t) {
+		return o.convertValue(valueOf(str), t)
+	}
+
+	if t.Kind() == reflect.Ptr {
+		ev, err := o.parseString(str, t.Elem())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		pv := reflect.New(t.Elem())
+		pv.Elem().Set(ev)
+		return pv, nil
+	}
+
+	v := reflect.New(t).Elem()
+
+	switch t {
+	case typeOfDuration:
+		d, err := time.ParseDuration(str)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v.SetInt(int64(d))
+		return v, nil
+	case typeOfTime:
+		tm, err := time.Parse(time.RFC3339, str)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v.Set(valueOf(tm))
+		return v, nil
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		v.SetString(str)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(str)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(str, 10, t.Bits())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(str, 10, t.Bits())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		fl, err := strconv.ParseFloat(str, t.Bits())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v.SetFloat(fl)
+	case reflect.Interface:
+		if !typeOfString.Implements(t) {
+			return reflect.Value{}, newError(ErrTypeMismatch, "cannot parse string into [%v]", t)
+		}
+		v.Set(valueOf(str))
+	default:
+		return reflect.Value{}, newError(ErrTypeMismatch, "cannot parse string into [%v]", t)
+	}
+
+	return v, nil
+}
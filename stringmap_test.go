@@ -0,0 +1,112 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFromStringMap(t *testing.T) {
+	type Meta struct {
+		Region string `model:"region"`
+	}
+
+	type SampleStruct struct {
+		Meta
+		Name      string
+		Count     int           `model:"count"`
+		Small     int8          `model:"small"`
+		Size      uint16        `model:"size"`
+		Ratio     float64       `model:"ratio"`
+		Enabled   bool          `model:"enabled"`
+		EnabledP  *bool         `model:"enabledPtr"`
+		TTL       time.Duration `model:"ttl"`
+		CreatedAt time.Time     `model:"createdAt"`
+		Skipped   string        `model:"-"`
+		Untouched string
+	}
+
+	dst := SampleStruct{Untouched: "keep"}
+	errs := FromStringMap(&dst, map[string]string{
+		"region":     "IN",
+		"Name":       "go-model",
+		"count":      "100",
+		"small":      "-8",
+		"size":       "1024",
+		"ratio":      "0.75",
+		"enabled":    "true",
+		"enabledPtr": "1",
+		"ttl":        "30s",
+		"createdAt":  "2018-08-27T10:00:00Z",
+		"-":          "ignored",
+		"unknown":    "ignored",
+	})
+	assertEqual(t, true, errs == nil)
+
+	assertEqual(t, "IN", dst.Region)
+	assertEqual(t, "go-model", dst.Name)
+	assertEqual(t, 100, dst.Count)
+	assertEqual(t, int8(-8), dst.Small)
+	assertEqual(t, uint16(1024), dst.Size)
+	assertEqual(t, 0.75, dst.Ratio)
+	assertEqual(t, true, dst.Enabled)
+	assertEqual(t, true, *dst.EnabledP)
+	assertEqual(t, 30*time.Second, dst.TTL)
+	assertEqual(t, true, dst.CreatedAt.Equal(time.Date(2018, 8, 27, 10, 0, 0, 0, time.UTC)))
+	assertEqual(t, "", dst.Skipped)
+	assertEqual(t, "keep", dst.Untouched)
+}
+
+func TestFromStringMapParseErrors(t *testing.T) {
+	type SampleStruct struct {
+		Count   int
+		Small   int8
+		Enabled bool
+		Items   []string
+	}
+
+	dst := SampleStruct{}
+	errs := FromStringMap(&dst, map[string]string{
+		"Count":   "abc",
+		"Small":   "1000",
+		"Enabled": "yes",
+		"Items":   "a,b",
+	})
+	assertEqual(t, 4, len(errs))
+	assertEqual(t, true, strings.HasPrefix(errs[0].Error(), "Field: 'Count',"))
+	assertEqual(t, true, strings.HasPrefix(errs[1].Error(), "Field: 'Small',"))
+	assertEqual(t, true, strings.HasPrefix(errs[2].Error(), "Field: 'Enabled',"))
+	assertEqual(t, "Field: 'Items', cannot parse string into [[]string]", errs[3].Error())
+}
+
+func TestFromStringMapWithConverter(t *testing.T) {
+	type SampleStruct struct {
+		Items []string
+	}
+
+	AddConversion((*string)(nil), (*[]string)(nil), func(in reflect.Value) (reflect.Value, error) {
+		return reflect.ValueOf(strings.Split(in.String(), ",")), nil
+	})
+	defer RemoveConversion((*string)(nil), (*[]string)(nil))
+
+	dst := SampleStruct{}
+	errs := FromStringMap(&dst, map[string]string{"Items": "a,b"})
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, []string{"a", "b"}, dst.Items)
+}
+
+func TestFromStringMapValidation(t *testing.T) {
+	errs := FromStringMap(nil, nil)
+	assertEqual(t, "Destination is nil", errs[0].Error())
+
+	errs = FromStringMap(&[]string{}, nil)
+	assertEqual(t, "Destination is not a struct", errs[0].Error())
+
+	errs = FromStringMap(struct{ Name string }{}, nil)
+	assertEqual(t, "Destination struct is not a pointer", errs[0].Error())
+}
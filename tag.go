@@ -5,7 +5,6 @@
 package model
 
 import (
-	"fmt"
 	"reflect"
 	"strings"
 )
@@ -13,6 +12,9 @@ import (
 type tag struct {
 	Name    string
 	Options string
+
+	// opts are the parsed options, option name mapped to it's value
+	opts map[string]string
 }
 
 // Tag method returns the exported struct field `Tag` value from the given struct.
@@ -37,11 +39,11 @@ func Tag(s interface{}, name string) (reflect.StructTag, error) {
 		return "", err
 	}
 
-	if fv, ok := sv.Type().FieldByName(name); ok {
+	if fv, ok := structField(sv.Type(), name); ok {
 		return fv.Tag, nil
 	}
 
-	return "", fmt.Errorf("Field: '%v', does not exists", name)
+	return "", newError(ErrFieldNotFound, "Field: '%v', does not exists", name)
 }
 
 // Tags method returns the exported struct fields `Tag` value from the given struct.
@@ -80,9 +82,33 @@ func newTag(modelTag string) *tag {
 	t.Name = values[0]
 	t.Options = strings.Join(values[1:], ",")
 
+	t.opts = map[string]string{}
+	for _, opt := range values[1:] {
+		opt = strings.TrimSpace(opt)
+		name, value := opt, ""
+		if i := strings.Index(opt, "="); i >= 0 {
+			name, value = opt[:i], opt[i+1:]
+		}
+
+		// first occurrence of the option wins
+		if _, found := t.opts[name]; !found {
+			t.opts[name] = value
+		}
+	}
+
 	return &t
 }
 
+// keyName returns the key name of field for map, it's tag name if present
+// otherwise field name.
+func (t *tag) keyName(f reflect.StructField) string {
+	if isStringEmpty(t.Name) {
+		return f.Name
+	}
+
+	return t.Name
+}
+
 func (t *tag) isOmitField() bool {
 	return t.Name == OmitField
 }
@@ -91,12 +117,74 @@ func (t *tag) isOmitEmpty() bool {
 	return t.isExists(OmitEmpty)
 }
 
+func (t *tag) isOmitZero() bool {
+	return t.isExists(OmitZero)
+}
+
 func (t *tag) isNoTraverse() bool {
 	return t.isExists(NoTraverse)
 }
 
+func (t *tag) isRedact() bool {
+	return t.isExists(Redact)
+}
+
+func (t *tag) isRequired() bool {
+	return t.isExists(Required)
+}
+
+func (t *tag) isAppend() bool {
+	return t.isExists(Append)
+}
+
+func (t *tag) isMerge() bool {
+	return t.isExists(Merge)
+}
+
+func (t *tag) isInline() bool {
+	return t.isExists(Inline) || t.isExists(Squash)
+}
+
+// expandFields method returns the field names of "expand" option.
+func (t *tag) expandFields() ([]string, bool) {
+	v, found := t.option(Expand)
+	if !found {
+		return nil, false
+	}
+
+	names := strings.Fields(v)
+	return names, len(names) > 0
+}
+
 func (t *tag) isExists(opt string) bool {
-	return strings.Contains(t.Options, opt)
+	_, found := t.option(opt)
+	return found
+}
+
+// option method returns the value of given tag option. The option is either
+// a flag "name" or has value "name=value".
+func (t *tag) option(name string) (string, bool) {
+	if len(t.Options) == 0 {
+		return "", false
+	}
+
+	if t.opts != nil {
+		v, found := t.opts[name]
+		return v, found
+	}
+
+	for _, opt := range strings.Split(t.Options, ",") {
+		opt = strings.TrimSpace(opt)
+		if opt == name {
+			return "", true
+		}
+
+		if strings.HasPrefix(opt, name+"=") {
+			return opt[len(name)+1:], true
+		}
+	}
+
+	return "", false
 }
 
 func isStringEmpty(str string) bool {
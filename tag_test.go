@@ -164,3 +164,28 @@ func TestIsNoTraverse(t *testing.T) {
 	logIt(t, "Model Tag", tag5)
 	assertEqual(t, false, tag5.isNoTraverse())
 }
+
+func TestTagOption(t *testing.T) {
+	tag := newTag("fieldName,omitempty,expand=First Last, expander=csv")
+
+	v, found := tag.option("expand")
+	assertEqual(t, true, found)
+	assertEqual(t, "First Last", v)
+
+	v, found = tag.option("expander")
+	assertEqual(t, true, found)
+	assertEqual(t, "csv", v)
+
+	_, found = tag.option("omit")
+	assertEqual(t, false, found)
+	assertEqual(t, true, tag.isOmitEmpty())
+
+	names, found := tag.expandFields()
+	assertEqual(t, true, found)
+	assertEqual(t, []string{"First", "Last"}, names)
+
+	// option value doesn't qualify as an option
+	tag = newTag(",expand=Redacted Required")
+	assertEqual(t, false, tag.isRedact())
+	assertEqual(t, false, tag.isRequired())
+}
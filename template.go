@@ -0,0 +1,163 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// TemplateTimeFormat is the layout used by `TemplateData()` method to
+// stringify `time.Time` values.
+var TemplateTimeFormat = time.RFC3339
+
+// TemplateData method maps the given `struct` into `map[string]interface{}` with
+// template friendly conventions, so it can be supplied to `text/template` and
+// `html/template` directly. If input is not a struct, method returns empty map.
+// 		Example:
+//
+// 		err := tmpl.Execute(w, model.TemplateData(user))
+//
+// 		// in template
+// 		{{ .name }} joined on {{ .createdAt }}, lives in {{ .address.city }}
+//
+// Conventions:
+// [1] `time.Time` values are stringified with `TemplateTimeFormat`, zero time is "".
+// [2] Embedded struct fields appear at same level as represented by Go.
+// [3] Pointers are dereferenced, nil pointer is replaced with zero value of it's type.
+// [4] Map keys are stringified, slice/array elements are processed as well.
+//
+// The key name and "model" tag options follow the same rule of `Map()` method,
+// field having "redact" option is always masked with `RedactMaskValue`.
+//
+func TemplateData(s interface{}) map[string]interface{} {
+	sv, err := structValue(s)
+	if err != nil {
+		return map[string]interface{}{}
+	}
+
+	tm := &templater{visiting: map[visitKey]bool{}, zeroing: map[reflect.Type]bool{}}
+	return tm.templateStruct(sv)
+}
+
+type templater struct {
+	// visiting keeps track of pointers which are in process
+	visiting map[visitKey]bool
+
+	// zeroing keeps track of types, nil pointer of them are in process
+	zeroing map[reflect.Type]bool
+}
+
+func (tm *templater) templateStruct(sv reflect.Value) map[string]interface{} {
+	m := map[string]interface{}{}
+
+	for _, f := range modelFields(sv) {
+		fv := sv.FieldByName(f.Name)
+		tag := newTag(f.Tag.Get(TagName))
+
+		if tag.isOmitField() {
+			continue
+		}
+
+		keyName := tag.keyName(f)
+		if tag.isRedact() {
+			m[keyName] = RedactMaskValue
+			continue
+		}
+
+		noTraverse := (isNoTraverseType(fv) || tag.isNoTraverse())
+		v := tm.templateVal(fv, noTraverse)
+
+		// embedded struct values gets mapped at embedded level
+		if fm, ok := v.(map[string]interface{}); ok && f.Anonymous && isStruct(fv) {
+			for k, ev := range fm {
+				m[k] = ev
+			}
+			continue
+		}
+
+		m[keyName] = v
+	}
+
+	return m
+}
+
+func (tm *templater) templateVal(v reflect.Value, notraverse bool) interface{} {
+	for isPtr(v) || isInterface(v) {
+		if isInterface(v) {
+			if v.IsNil() {
+				return nil
+			}
+
+			v = v.Elem()
+			continue
+		}
+
+		// nil pointer is dereferenced into zero value of it's type
+		if v.IsNil() {
+			et := v.Type().Elem()
+			if tm.zeroing[et] {
+				return nil
+			}
+
+			tm.zeroing[et] = true
+			defer delete(tm.zeroing, et)
+
+			v = reflect.Zero(et)
+			continue
+		}
+
+		key := visitKeyOf(v)
+		if tm.visiting[key] {
+			return nil
+		}
+
+		tm.visiting[key] = true
+		defer delete(tm.visiting, key)
+
+		v = v.Elem()
+	}
+
+	if v.Type() == typeOfTime {
+		t := v.Interface().(time.Time)
+		if t.IsZero() {
+			return ""
+		}
+
+		return t.Format(TemplateTimeFormat)
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		if notraverse || isNoTraverseType(v) {
+			break
+		}
+
+		return tm.templateStruct(v)
+	case reflect.Slice, reflect.Array:
+		if v.Type() == typeOfBytes {
+			break
+		}
+
+		items := make([]interface{}, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			ev := v.Index(i)
+			items[i] = tm.templateVal(ev, isNoTraverseType(ev))
+		}
+
+		return items
+	case reflect.Map:
+		nm := map[string]interface{}{}
+		for _, k := range v.MapKeys() {
+			ev := v.MapIndex(k)
+			nm[fmt.Sprintf("%v", k.Interface())] = tm.templateVal(ev, isNoTraverseType(ev))
+		}
+
+		return nm
+	}
+
+	return v.Interface()
+}
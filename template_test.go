@@ -0,0 +1,73 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"bytes"
+	"testing"
+	"text/template"
+	"time"
+)
+
+func TestTemplateData(t *testing.T) {
+	type Address struct {
+		City string `model:"city"`
+	}
+
+	type Audit struct {
+		CreatedAt time.Time  `model:"createdAt"`
+		UpdatedAt *time.Time `model:"updatedAt"`
+	}
+
+	type Node struct {
+		Name string `model:"name"`
+		Next *Node  `model:"next"`
+	}
+
+	type SampleStruct struct {
+		Audit
+		Name     string            `model:"name"`
+		Password string            `model:"password,redact"`
+		Nickname *string           `model:"nickname"`
+		Address  *Address          `model:"address"`
+		Tags     []string          `model:"tags"`
+		Labels   map[int]string    `model:"labels"`
+		Items    []Address         `model:"items"`
+		Node     Node              `model:"node"`
+		Extra    map[string]string `model:"-"`
+	}
+
+	createdAt := time.Date(2016, 8, 1, 10, 30, 0, 0, time.UTC)
+	src := SampleStruct{
+		Audit:    Audit{CreatedAt: createdAt},
+		Name:     "go-model",
+		Password: "s3cr3t",
+		Tags:     []string{"model", "reflect"},
+		Labels:   map[int]string{1: "one"},
+		Items:    []Address{{City: "Chennai"}},
+	}
+
+	data := TemplateData(&src)
+	assertEqual(t, "2016-08-01T10:30:00Z", data["createdAt"])
+	assertEqual(t, "", data["updatedAt"])
+	assertEqual(t, "go-model", data["name"])
+	assertEqual(t, RedactMaskValue, data["password"])
+	assertEqual(t, "", data["nickname"])
+	assertEqual(t, "", data["address"].(map[string]interface{})["city"])
+	assertEqual(t, "one", data["labels"].(map[string]interface{})["1"])
+	assertEqual(t, "Chennai", data["items"].([]interface{})[0].(map[string]interface{})["city"])
+	assertEqual(t, true, data["node"].(map[string]interface{})["next"].(map[string]interface{})["next"] == nil)
+
+	_, found := data["Extra"]
+	assertEqual(t, false, found)
+
+	tmpl := template.Must(template.New("user").Parse(`{{ .name }} since {{ .createdAt }} from {{ .address.city }}{{ range .tags }} #{{ . }}{{ end }}`))
+	var buf bytes.Buffer
+	err := tmpl.Execute(&buf, data)
+	assertError(t, err)
+	assertEqual(t, "go-model since 2016-08-01T10:30:00Z from  #model #reflect", buf.String())
+
+	assertEqual(t, 0, len(TemplateData("not a struct")))
+}
@@ -0,0 +1,76 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"encoding"
+	"reflect"
+)
+
+var (
+	typeOfTextMarshaler   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	typeOfTextUnmarshaler = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// WithTextMarshaler option makes `Map()` method to emit the text form of value
+// which implements `encoding.TextMarshaler` and `FromMap()` method to parse the
+// string value with `encoding.TextUnmarshaler` of the field type. So the types
+// like `time.Time`, `net.IP` and custom identifier types are supported without
+// registering converters. Value is mapped as-is if it fails to marshal.
+// 		Example:
+//
+// 		// CreatedAt time.Time field appears as "2017-01-02T15:04:05Z"
+// 		m, err := model.Map(src, model.WithTextMarshaler())
+//
+// 		errs := model.FromMap(&dst, m, model.WithTextMarshaler())
+//
+func WithTextMarshaler() Option {
+	return func(o *options) {
+		o.textMarshal = true
+	}
+}
+
+// isTextMarshaler method reports the value implements `encoding.TextMarshaler`,
+// pointer receiver method is considered too.
+func isTextMarshaler(v reflect.Value) bool {
+	if !v.IsValid() {
+		return false
+	}
+
+	return implements(v, typeOfTextMarshaler)
+}
+
+// marshalText method returns the text form of the value, ok is false if the
+// value doesn't implement `encoding.TextMarshaler` or fails to marshal.
+func marshalText(v reflect.Value) (string, bool) {
+	if !isTextMarshaler(v) || !v.CanInterface() || (isPtr(v) && v.IsNil()) {
+		return "", false
+	}
+
+	if !v.Type().Implements(typeOfTextMarshaler) {
+		v = addressable(v).Addr()
+	}
+
+	b, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+	if err != nil {
+		return "", false
+	}
+
+	return string(b), true
+}
+
+// unmarshalText method parses the text into field value, if the field type
+// implements `encoding.TextUnmarshaler`. handled is false otherwise.
+func unmarshalText(fv reflect.Value, text, path string) (handled bool, errs []error) {
+	if !fv.CanAddr() || !reflect.PtrTo(fv.Type()).Implements(typeOfTextUnmarshaler) {
+		return false, nil
+	}
+
+	if err := fv.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(text)); err != nil {
+		return true, []error{valueError(path, valueOf(text), fv.Type(), err)}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,96 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+type sampleTextCode struct {
+	Prefix string
+	Number string
+}
+
+func (c *sampleTextCode) MarshalText() ([]byte, error) {
+	return []byte(c.Prefix + "-" + c.Number), nil
+}
+
+func (c *sampleTextCode) UnmarshalText(text []byte) error {
+	parts := strings.SplitN(string(text), "-", 2)
+	if len(parts) != 2 {
+		return errors.New("invalid code")
+	}
+
+	c.Prefix, c.Number = parts[0], parts[1]
+	return nil
+}
+
+func TestMapTextMarshaler(t *testing.T) {
+	type Order struct {
+		Code      sampleTextCode
+		Codes     []sampleTextCode
+		Parent    *sampleTextCode
+		Addr      net.IP
+		CreatedAt time.Time
+		UpdatedAt time.Time
+	}
+
+	createdAt := time.Date(2017, 1, 2, 15, 4, 5, 0, time.UTC)
+	src := Order{
+		Code:      sampleTextCode{"ORD", "101"},
+		Codes:     []sampleTextCode{{"ORD", "102"}},
+		Addr:      net.ParseIP("10.0.0.1"),
+		CreatedAt: createdAt,
+	}
+
+	m, err := Map(src, WithTextMarshaler())
+	assertError(t, err)
+	assertEqual(t, "ORD-101", m["Code"])
+	assertEqual(t, []interface{}{"ORD-102"}, m["Codes"])
+	assertEqual(t, true, m["Parent"].(*sampleTextCode) == nil)
+	assertEqual(t, "10.0.0.1", m["Addr"])
+	assertEqual(t, "2017-01-02T15:04:05Z", m["CreatedAt"])
+	assertEqual(t, "0001-01-01T00:00:00Z", m["UpdatedAt"])
+
+	// struct is mapped by default
+	m, err = Map(src)
+	assertError(t, err)
+	assertEqual(t, map[string]interface{}{"Prefix": "ORD", "Number": "101"}, m["Code"])
+}
+
+func TestFromMapTextUnmarshaler(t *testing.T) {
+	type Order struct {
+		Code      sampleTextCode
+		Codes     []sampleTextCode
+		Parent    *sampleTextCode
+		Addr      net.IP
+		CreatedAt time.Time
+	}
+
+	m := map[string]interface{}{
+		"Code":      "ORD-101",
+		"Codes":     []interface{}{"ORD-102"},
+		"Parent":    "ORD-100",
+		"Addr":      "10.0.0.1",
+		"CreatedAt": "2017-01-02T15:04:05Z",
+	}
+
+	var dst Order
+	errs := FromMap(&dst, m, WithTextMarshaler())
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, true, dst.Code == sampleTextCode{"ORD", "101"})
+	assertEqual(t, true, dst.Codes[0] == sampleTextCode{"ORD", "102"})
+	assertEqual(t, true, *dst.Parent == sampleTextCode{"ORD", "100"})
+	assertEqual(t, "10.0.0.1", dst.Addr.String())
+	assertEqual(t, true, dst.CreatedAt.Equal(time.Date(2017, 1, 2, 15, 4, 5, 0, time.UTC)))
+
+	errs = FromMap(&dst, map[string]interface{}{"Code": "ORD"}, WithTextMarshaler())
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, "Field: 'Code', invalid code", errs[0].Error())
+}
@@ -0,0 +1,163 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// UnixTimeFormat is the "timefmt" option value for `time.Time` and unix
+// seconds conversion, for eg.: `model:"created,timefmt=unix"`
+const UnixTimeFormat = "unix"
+
+// namedTimeLayouts is the layouts of `time` package by constant name, tag options
+// are comma separated, so the layout which has comma (for eg.: `time.RFC1123`)
+// is given by name, for eg.: `model:"created,timefmt=RFC1123"`
+var namedTimeLayouts = map[string]string{
+	"ANSIC":       time.ANSIC,
+	"UnixDate":    time.UnixDate,
+	"RubyDate":    time.RubyDate,
+	"RFC822":      time.RFC822,
+	"RFC822Z":     time.RFC822Z,
+	"RFC850":      time.RFC850,
+	"RFC1123":     time.RFC1123,
+	"RFC1123Z":    time.RFC1123Z,
+	"RFC3339":     time.RFC3339,
+	"RFC3339Nano": time.RFC3339Nano,
+	"Kitchen":     time.Kitchen,
+	"Stamp":       time.Stamp,
+	"StampMilli":  time.StampMilli,
+	"StampMicro":  time.StampMicro,
+	"StampNano":   time.StampNano,
+	"DateTime":    time.DateTime,
+	"DateOnly":    time.DateOnly,
+	"TimeOnly":    time.TimeOnly,
+}
+
+// timeLayout method returns the layout of "timefmt" option, layout constant
+// name of `time` package is resolved into its layout.
+func (t *tag) timeLayout() (string, bool) {
+	layout, found := t.option(TimeFormat)
+	if named, ok := namedTimeLayouts[layout]; ok {
+		layout = named
+	}
+
+	return layout, found
+}
+
+// timeFormatConverter method returns the converter between `time.Time` and
+// string as per given layout, or integer unix seconds for `UnixTimeFormat`.
+// Pointer of these types is adapted. It returns nil if the types are not
+// applicable.
+func timeFormatConverter(layout string, st, dt reflect.Type) Converter {
+	et := indirectType(dt)
+	unix := layout == UnixTimeFormat
+
+	var convert func(in reflect.Value) (reflect.Value, error)
+	switch st = indirectType(st); {
+	case st == typeOfTime && et.Kind() == reflect.String && !unix:
+		convert = func(in reflect.Value) (reflect.Value, error) {
+			return valueOf(in.Interface().(time.Time).Format(layout)).Convert(et), nil
+		}
+	case st.Kind() == reflect.String && et == typeOfTime && !unix:
+		convert = func(in reflect.Value) (reflect.Value, error) {
+			t, err := time.Parse(layout, in.String())
+			return valueOf(t), err
+		}
+	case st == typeOfTime && isIntegerKind(et.Kind()) && unix:
+		convert = func(in reflect.Value) (reflect.Value, error) {
+			return valueOf(in.Interface().(time.Time).Unix()).Convert(et), nil
+		}
+	case isIntegerKind(st.Kind()) && et == typeOfTime && unix:
+		convert = func(in reflect.Value) (reflect.Value, error) {
+			if isSignedKind(in.Kind()) {
+				return valueOf(time.Unix(in.Int(), 0).UTC()), nil
+			}
+			return valueOf(time.Unix(int64(in.Uint()), 0).UTC()), nil
+		}
+	default:
+		return nil
+	}
+
+	return func(in reflect.Value) (reflect.Value, error) {
+		if isPtr(in) {
+			if in.IsNil() {
+				return reflect.Zero(dt), nil
+			}
+			in = in.Elem()
+		}
+
+		v, err := convert(in)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		if dt.Kind() == reflect.Ptr {
+			pv := reflect.New(et)
+			pv.Elem().Set(v)
+			return pv, nil
+		}
+
+		return v, nil
+	}
+}
+
+// mapTimeFormatted method formats the `time.Time` field value as per layout of
+// "timefmt" option for `Map()` method, it reports whether the value is formatted.
+func mapTimeFormatted(fv reflect.Value, layout string) (interface{}, bool) {
+	if indirectType(fv.Type()) != typeOfTime {
+		return nil, false
+	}
+
+	var dt reflect.Type = typeOfString
+	if layout == UnixTimeFormat {
+		dt = reflect.TypeOf(int64(0))
+	}
+
+	v, err := timeFormatConverter(layout, fv.Type(), dt)(fv)
+	if err != nil || !v.IsValid() {
+		return nil, false
+	}
+
+	return v.Interface(), true
+}
+
+// fromTimeFormatted method parses the string or unix seconds value into
+// `time.Time` field as per layout of "timefmt" option for `FromMap()` method,
+// handled is false if the types are not applicable.
+func fromTimeFormatted(fv reflect.Value, val interface{}, layout, path string, o *options) (handled bool, errs []error) {
+	vv := valueOf(val)
+	if !vv.IsValid() || indirectType(fv.Type()) != typeOfTime {
+		return false, nil
+	}
+
+	// unix seconds may come as number string or float, for eg.: JSON number
+	if layout == UnixTimeFormat {
+		switch vv.Kind() {
+		case reflect.String:
+			sec, err := strconv.ParseInt(vv.String(), 10, 64)
+			if err != nil {
+				return true, []error{valueError(path, vv, fv.Type(), err)}
+			}
+			vv = valueOf(sec)
+		case reflect.Float32, reflect.Float64:
+			vv = valueOf(int64(vv.Float()))
+		}
+	}
+
+	c := timeFormatConverter(layout, vv.Type(), fv.Type())
+	if c == nil {
+		return false, nil
+	}
+
+	v, err := c(vv)
+	if err != nil {
+		return true, []error{valueError(path, vv, fv.Type(), err)}
+	}
+
+	return true, assignField(fv, v, path, o)
+}
@@ -0,0 +1,118 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCopyTimeFormat(t *testing.T) {
+	type Event struct {
+		Date     time.Time  `model:"date,timefmt=2006-01-02"`
+		Created  time.Time  `model:"created,timefmt=unix"`
+		Updated  *time.Time `model:"updated,timefmt=2006-01-02 15:04"`
+		Archived time.Time
+	}
+
+	type EventDto struct {
+		Date     string
+		Created  int64
+		Updated  string
+		Archived string `model:",timefmt=Jan 2 2006"`
+	}
+
+	at := time.Date(2017, 1, 2, 15, 4, 5, 0, time.UTC)
+	src := Event{Date: at, Created: at, Updated: &at, Archived: at}
+
+	var dto EventDto
+	errs := Copy(&dto, src)
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, "2017-01-02", dto.Date)
+	assertEqual(t, int64(1483369445), dto.Created)
+	assertEqual(t, "2017-01-02 15:04", dto.Updated)
+	assertEqual(t, "Jan 2 2017", dto.Archived)
+
+	// reverse direction
+	var dst Event
+	errs = Copy(&dst, dto)
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, true, dst.Date.Equal(time.Date(2017, 1, 2, 0, 0, 0, 0, time.UTC)))
+	assertEqual(t, true, dst.Created.Equal(at))
+	assertEqual(t, true, dst.Updated.Equal(time.Date(2017, 1, 2, 15, 4, 0, 0, time.UTC)))
+	assertEqual(t, true, dst.Archived.Equal(time.Date(2017, 1, 2, 0, 0, 0, 0, time.UTC)))
+
+	errs = Copy(&dst, EventDto{Date: "02/01/2017"})
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, true, strings.HasPrefix(errs[0].Error(), "Field: 'Date', parsing time"))
+}
+
+func TestMapTimeFormat(t *testing.T) {
+	type Event struct {
+		Date    time.Time  `model:"date,timefmt=2006-01-02"`
+		Created time.Time  `model:"created,timefmt=unix"`
+		Updated *time.Time `model:"updated,timefmt=2006-01-02"`
+		Name    string     `model:"name,timefmt=2006-01-02"`
+	}
+
+	at := time.Date(2017, 1, 2, 15, 4, 5, 0, time.UTC)
+	src := Event{Date: at, Created: at, Updated: &at, Name: "release"}
+
+	m, err := Map(src)
+	assertError(t, err)
+	assertEqual(t, "2017-01-02", m["date"])
+	assertEqual(t, int64(1483369445), m["created"])
+	assertEqual(t, "2017-01-02", m["updated"])
+	assertEqual(t, "release", m["name"])
+
+	// round trip, JSON number of unix seconds too
+	var dst Event
+	errs := FromMap(&dst, m)
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, true, dst.Date.Equal(time.Date(2017, 1, 2, 0, 0, 0, 0, time.UTC)))
+	assertEqual(t, true, dst.Created.Equal(at))
+	assertEqual(t, true, dst.Updated.Equal(time.Date(2017, 1, 2, 0, 0, 0, 0, time.UTC)))
+
+	dst = Event{}
+	errs = FromJSON(&dst, []byte(`{"created": 1483369445}`))
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, true, dst.Created.Equal(at))
+
+	errs = FromMap(&dst, map[string]interface{}{"date": "2017/01/02"})
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, true, strings.HasPrefix(errs[0].Error(), "Field: 'Date', parsing time"))
+}
+
+func TestNamedTimeFormat(t *testing.T) {
+	type Event struct {
+		Date    time.Time `model:"date,timefmt=RFC1123"`
+		Release time.Time `model:"release,timefmt=DateOnly"`
+	}
+
+	type EventDto struct {
+		Date    string
+		Release string
+	}
+
+	at := time.Date(2017, 1, 2, 15, 4, 5, 0, time.UTC)
+	src := Event{Date: at, Release: at}
+
+	m, err := Map(src)
+	assertError(t, err)
+	assertEqual(t, "Mon, 02 Jan 2017 15:04:05 UTC", m["date"])
+	assertEqual(t, "2017-01-02", m["release"])
+
+	var dst Event
+	errs := FromMap(&dst, m)
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, true, dst.Date.Equal(at))
+
+	var dto EventDto
+	errs = Copy(&dto, src)
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, "Mon, 02 Jan 2017 15:04:05 UTC", dto.Date)
+	assertEqual(t, "2017-01-02", dto.Release)
+}
@@ -0,0 +1,63 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import "reflect"
+
+// Transform method applies the fn on every field of given `struct` pointer that
+// satisfies the match, and sets the result into field in place. The fields of nested
+// struct and struct within slice/array are processed as well, see `Walk()` method.
+// Transform continues regardless of errors, which gets added to '[]error'.
+// 		Example:
+//
+// 		// trim all the string fields
+// 		errs := model.Transform(&src,
+// 			func(f reflect.StructField) bool { return f.Type.Kind() == reflect.String },
+// 			func(v reflect.Value) (reflect.Value, error) {
+// 				return reflect.ValueOf(strings.TrimSpace(v.String())), nil
+// 			})
+//
+// Note: Field of struct within map is not settable, it's reported as an error.
+//
+// A "model" tag with the value of "-" is ignored by library for processing.
+//
+// A "model" tag value with the option of "notraverse"; library will not traverse
+// inside the struct object, however the field itself is evaluated for match.
+//
+func Transform(s interface{}, match func(reflect.StructField) bool, fn func(reflect.Value) (reflect.Value, error)) []error {
+	sv, err := destStructValue(s)
+	if err != nil {
+		return []error{err}
+	}
+
+	var errs []error
+	_ = Walk(sv.Addr().Interface(), func(path string, f reflect.StructField, v reflect.Value) error {
+		if !match(f) {
+			return nil
+		}
+
+		if !v.CanSet() {
+			errs = append(errs, newFieldError(path, v.Type(), nil, ErrNotSettable, "cannot be settable"))
+			return nil
+		}
+
+		nv, err := fn(v)
+		if err != nil {
+			errs = append(errs, newFieldError(path, v.Type(), nil, err, "%v", err))
+			return nil
+		}
+
+		if !nv.IsValid() || !nv.Type().AssignableTo(v.Type()) {
+			errs = append(errs, newFieldError(path, nil, v.Type(), ErrTypeMismatch,
+				"transformed value is not assignable to [%v]", v.Type()))
+			return nil
+		}
+
+		v.Set(nv)
+		return nil
+	})
+
+	return errs
+}
@@ -0,0 +1,82 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTransform(t *testing.T) {
+	type Item struct {
+		Name string
+	}
+
+	type SampleStruct struct {
+		Name      string
+		Skipped   string `model:"-"`
+		Items     []Item
+		Ptr       *Item
+		ByKey     map[string]Item
+		CreatedAt time.Time
+		UpdatedAt *time.Time
+	}
+
+	ist := time.FixedZone("IST", 5*3600+1800)
+	updatedAt := time.Date(2018, 8, 27, 15, 30, 0, 0, ist)
+	src := SampleStruct{
+		Name:      "  go-model ",
+		Skipped:   "  skipped ",
+		Items:     []Item{{Name: " a "}, {Name: "b "}},
+		Ptr:       &Item{Name: " c"},
+		CreatedAt: time.Date(2018, 8, 27, 15, 30, 0, 0, ist),
+		UpdatedAt: &updatedAt,
+	}
+
+	isString := func(f reflect.StructField) bool { return f.Type.Kind() == reflect.String }
+	trim := func(v reflect.Value) (reflect.Value, error) {
+		return reflect.ValueOf(strings.TrimSpace(v.String())), nil
+	}
+
+	errs := Transform(&src, isString, trim)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, "go-model", src.Name)
+	assertEqual(t, "  skipped ", src.Skipped)
+	assertEqual(t, "a", src.Items[0].Name)
+	assertEqual(t, "b", src.Items[1].Name)
+	assertEqual(t, "c", src.Ptr.Name)
+
+	// normalize time to UTC
+	errs = Transform(&src,
+		func(f reflect.StructField) bool { return f.Type == typeOfTime },
+		func(v reflect.Value) (reflect.Value, error) {
+			return reflect.ValueOf(v.Interface().(time.Time).UTC()), nil
+		})
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, true, src.CreatedAt.Location() == time.UTC)
+	assertEqual(t, "10:00", src.CreatedAt.Format("15:04"))
+
+	// errors
+	src.ByKey = map[string]Item{"x": {Name: " x "}}
+	errs = Transform(&src, isString, func(v reflect.Value) (reflect.Value, error) {
+		if v.String() == "b" {
+			return reflect.Value{}, errors.New("invalid value")
+		}
+		if v.String() == "c" {
+			return reflect.ValueOf(10), nil
+		}
+		return v, nil
+	})
+	assertEqual(t, 3, len(errs))
+	assertEqual(t, "Field: 'Items[1].Name', invalid value", errs[0].Error())
+	assertEqual(t, "Field: 'Ptr.Name', transformed value is not assignable to [string]", errs[1].Error())
+	assertEqual(t, "Field: 'ByKey[x].Name', cannot be settable", errs[2].Error())
+
+	errs = Transform(src, isString, trim)
+	assertEqual(t, "Destination struct is not a pointer", errs[0].Error())
+}
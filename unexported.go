@@ -0,0 +1,119 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+// WithUnexported option copies the unexported fields too, when the source and
+// destination are identical struct types. Unexported fields are accessed using
+// package `unsafe`, it's useful to clone third-party types which keeps it's state
+// in unexported fields, without registering each as "No Traverse" type.
+// 		Example:
+//
+// 		result, err := model.Clone(src, model.WithUnexported())
+//
+// For `Map()` method, unexported field values are read and included in the result
+// map by field name, it's useful for debugging, snapshotting and diffing of structs
+// you don't own. Exported field takes precedence on key name conflict.
+// 		Example:
+//
+// 		m, err := model.Map(src, model.WithUnexported())
+//
+// Note: Unexported field values are processed as-is by `Copy()` and `Map()` rules,
+// tag options are not applicable to them. Use it with care, since it bypasses the
+// encapsulation of the type.
+//
+func WithUnexported() Option {
+	return func(o *options) {
+		o.unexported = true
+	}
+}
+
+// copyUnexported method copies the unexported fields of source struct into
+// destination struct of the same type.
+func copyUnexported(dv, sv reflect.Value, cs *copyState) []error {
+	var errs []error
+
+	// unsafe access requires addressable value
+	sv = addressable(sv)
+
+	st := sv.Type()
+	for i := 0; i < st.NumField() && !cs.stopped(errs); i++ {
+		if st.Field(i).IsExported() {
+			continue
+		}
+
+		sfv := exposed(sv.Field(i))
+		dfv := exposed(dv.Field(i))
+
+		// zero value is not traversed, so nil stays as nil
+		if isFieldZero(sfv) {
+			dfv.Set(reflect.Zero(dfv.Type()))
+			continue
+		}
+
+		v, err := copyVal(dfv.Type(), sfv, cs.opts.isNoTraverseType(sfv), cs)
+		errs = append(errs, fieldErrors(st.Field(i).Name, sfv.Type(), dfv.Type(), err)...)
+
+		if v.IsValid() {
+			dfv.Set(v)
+		}
+	}
+
+	return errs
+}
+
+// exposed method returns the readable and settable value of addressable
+// unexported field.
+func exposed(f reflect.Value) reflect.Value {
+	return reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Elem()
+}
+
+// mapUnexported method adds the unexported field values of struct into given map,
+// existing keys are not overwritten.
+func mapUnexported(m map[string]interface{}, sv reflect.Value, o *options) {
+	// unsafe access requires addressable value
+	sv = addressable(sv)
+
+	st := sv.Type()
+	for i := 0; i < st.NumField(); i++ {
+		f := st.Field(i)
+		if f.IsExported() {
+			continue
+		}
+
+		fv := exposed(sv.Field(i))
+		noTraverse := o.isNoTraverseType(fv)
+		o.mapping.field(f.Name)
+
+		var v interface{}
+		switch {
+		case isFieldZero(fv):
+			v = reflect.Zero(fv.Type()).Interface()
+		case isStruct(fv) && !noTraverse && !isInterface(fv):
+			fmv := doMap(fv, o)
+
+			// embedded struct values gets mapped at embedded level
+			if f.Anonymous {
+				for k, ev := range fmv {
+					if _, found := m[k]; !found {
+						m[k] = ev
+					}
+				}
+				continue
+			}
+			v = fmv
+		default:
+			v = mapVal(fv, noTraverse, o).Interface()
+		}
+
+		if _, found := m[f.Name]; !found {
+			m[f.Name] = v
+		}
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"errors"
+	"testing"
+)
+
+type sampleCounter struct {
+	name   string
+	hits   *int
+	labels []string
+	owner  *sampleCounter
+	meta   map[string]string
+	Public string
+}
+
+func TestCopyUnexported(t *testing.T) {
+	hits := 3
+	src := sampleCounter{name: "requests", hits: &hits, labels: []string{"a"}, Public: "yes"}
+	src.owner = &src
+
+	// by default unexported fields are not copied
+	result, err := Clone(src)
+	assertError(t, err)
+	cloned := result.(*sampleCounter)
+	assertEqual(t, "yes", cloned.Public)
+	assertEqual(t, "", cloned.name)
+
+	result, err = Clone(&src, WithUnexported())
+	assertError(t, err)
+	cloned = result.(*sampleCounter)
+	assertEqual(t, "requests", cloned.name)
+	assertEqual(t, 3, *cloned.hits)
+	assertEqual(t, true, cloned.hits != &hits)
+	assertEqual(t, []string{"a"}, cloned.labels)
+	assertEqual(t, true, cloned.owner == cloned)
+	assertEqual(t, true, cloned.meta == nil)
+
+	cloned.labels[0] = "b"
+	assertEqual(t, "a", src.labels[0])
+
+	dst := sampleCounter{}
+	errs := Copy(&dst, src, WithUnexported())
+	assertError(t, errors.Join(errs...))
+	assertEqual(t, "requests", dst.name)
+
+	// different struct types, unexported fields are not copied
+	type other struct {
+		name   string
+		Public string
+	}
+	o := other{}
+	errs = Copy(&o, src, WithUnexported())
+	assertError(t, errors.Join(errs...))
+	assertEqual(t, "", o.name)
+	assertEqual(t, "yes", o.Public)
+}
+
+func TestMapUnexported(t *testing.T) {
+	type state struct {
+		count int
+	}
+
+	type Sample struct {
+		Name    string
+		secret  string
+		state   state
+		labels  []string
+		pointer *state
+		state2  *state
+	}
+
+	src := Sample{Name: "go-model", secret: "s3cr3t", state: state{count: 2}, labels: []string{"a"}, state2: &state{count: 3}}
+
+	m, err := Map(src)
+	assertError(t, err)
+	assertEqual(t, map[string]interface{}{"Name": "go-model"}, m)
+
+	m, err = Map(src, WithUnexported())
+	assertError(t, err)
+	assertEqual(t, "go-model", m["Name"])
+	assertEqual(t, "s3cr3t", m["secret"])
+	assertEqual(t, map[string]interface{}{"count": 2}, m["state"])
+	assertEqual(t, []string{"a"}, m["labels"])
+	assertEqual(t, true, m["pointer"].(*state) == nil)
+	assertEqual(t, map[string]interface{}{"count": 3}, m["state2"])
+
+	// cycle via unexported field is detected
+	c := sampleCounter{name: "c"}
+	c.owner = &c
+	_, err = Map(&c, WithUnexported())
+	assertEqual(t, "Field: 'owner', cycle detected", err.Error())
+}
@@ -0,0 +1,275 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// MaxURLValuesIndex is the maximum slice index of the key accepted by
+// `FromURLValues()` method, so the slice cannot be grown arbitrarily by the input.
+const MaxURLValuesIndex = 1000
+
+// ToURLValues method converts the given `struct` into `url.Values` through `Map()`
+// method, so the query string and form encoding uses the same model definition.
+// Nested struct field key is dot-path, for eg.: "address.city", zero value nested
+// struct too; slice value is
+// added as multiple values of the key and slice of struct is keyed with index
+// notation, for eg.: "items[0].id". Nil value is left out, `time.Time` is formatted
+// in RFC3339 format. It accepts all the options of `Map()` method.
+// 		Example:
+//
+// 		src := SampleStruct { /* source struct field values go here */ }
+//
+// 		values, err := model.ToURLValues(src)
+// 		if err != nil {
+// 			fmt.Println("Error:", err)
+// 		}
+//
+// 		fmt.Println(values.Encode())
+//
+func ToURLValues(s interface{}, opts ...Option) (url.Values, error) {
+	m, err := Map(s, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	for k, v := range m {
+		if err = encodeURLValue(values, k, valueOf(v), opts); err != nil {
+			return nil, err
+		}
+	}
+
+	return values, nil
+}
+
+// FromURLValues method is the inverse of `ToURLValues()` method. It populates the
+// destination `struct` from the given `url.Values` through `FromMap()` method, so
+// the key names are looked up same as "model" tag. Nested struct field is looked
+// up by dot-path key and slice of struct by index notation, same as `ToURLValues()`
+// method; slice is grown up to the index, index beyond `MaxURLValuesIndex` is
+// reported as an error. Single value of the key is populated into slice field too, empty value
+// is zero value of the field and the string value is parsed into field type in weak
+// typing mode, see `WithWeakTyping()`.
+// It accepts all the options of `FromMap()` method.
+// 		Example:
+//
+// 		dst := SampleStruct{}
+// 		errs := model.FromURLValues(&dst, req.URL.Query())
+// 		if errs != nil {
+// 			fmt.Println("Errors:", errs)
+// 		}
+//
+func FromURLValues(dst interface{}, values url.Values, opts ...Option) []error {
+	dv, err := destStructValue(dst)
+	if err != nil {
+		return []error{err}
+	}
+
+	// keys are processed in order to have predictable result
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var errs []error
+	m := map[string]interface{}{}
+	for _, k := range keys {
+		vs := values[k]
+		if len(vs) == 0 {
+			continue
+		}
+
+		// empty value is zero value of the field
+		var v interface{}
+		if len(vs) == 1 && vs[0] != "" {
+			v = vs[0]
+		} else if len(vs) > 1 {
+			elems := make([]interface{}, len(vs))
+			for i, s := range vs {
+				elems[i] = s
+			}
+			v = elems
+		}
+
+		// key is not a valid path, so it's not of any field
+		segs, err := parsePath(k)
+		if err != nil {
+			continue
+		}
+
+		if err = setURLValue(m, k, segs, v); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	o := newOptions(append([]Option{WithWeakTyping()}, opts...))
+	o.singleAsSlice = true
+
+	fieldErrs, _ := fromMap(dv, m, "", o)
+	errs = append(errs, fieldErrs...)
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// encodeURLValue method adds the mapped value into url values, nested map and
+// struct is encoded with dot-path key.
+func encodeURLValue(values url.Values, key string, v reflect.Value, opts []Option) error {
+	if isInterface(v) || isPtr(v) {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	if !v.IsValid() {
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		// zero value struct is kept as-is by `Map()` method
+		if v.Type() == typeOfTime || isTextMarshaler(v) {
+			break
+		}
+
+		m, err := Map(v.Interface(), opts...)
+		if err != nil {
+			return err
+		}
+
+		return encodeURLValue(values, key, valueOf(m), opts)
+	case reflect.Map:
+		for _, k := range v.MapKeys() {
+			if err := encodeURLValue(values, joinPath(key, fmt.Sprintf("%v", k.Interface())), v.MapIndex(k), opts); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Slice, reflect.Array:
+		if v.Type() == typeOfBytes {
+			break
+		}
+
+		for i := 0; i < v.Len(); i++ {
+			ev := v.Index(i)
+			if isInterface(ev) || isPtr(ev) {
+				if ev.IsNil() {
+					continue
+				}
+				ev = ev.Elem()
+			}
+
+			if ev.Kind() == reflect.Map || (ev.Kind() == reflect.Struct && ev.Type() != typeOfTime && !isTextMarshaler(ev)) {
+				if err := encodeURLValue(values, fmt.Sprintf("%v[%d]", key, i), ev, opts); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if err := encodeURLValue(values, key, ev, opts); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	values.Add(key, formatURLValue(v))
+	return nil
+}
+
+// formatURLValue method formats the scalar value as string, so it's parsed back
+// into the same type.
+func formatURLValue(v reflect.Value) string {
+	switch v.Type() {
+	case typeOfTime:
+		return v.Interface().(time.Time).Format(time.RFC3339)
+	case typeOfDuration:
+		return time.Duration(v.Int()).String()
+	case typeOfBytes:
+		return string(v.Bytes())
+	}
+
+	switch v.Kind() {
+	case reflect.String, reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		return formatScalar(v)
+	}
+
+	if text, ok := marshalText(v); ok {
+		return text
+	}
+
+	return fmt.Sprintf("%v", v.Interface())
+}
+
+// setURLValue method sets the value into nested map as per key path, name with
+// index is the element of slice. Slice is grown up to the index, index is limited
+// to `MaxURLValuesIndex`.
+func setURLValue(m map[string]interface{}, key string, segs []pathSegment, v interface{}) error {
+	for i := 0; i < len(segs); i++ {
+		seg := segs[i]
+		if seg.isIndex {
+			return nil
+		}
+
+		// element of slice
+		if i+1 < len(segs) && segs[i+1].isIndex {
+			idx, err := strconv.Atoi(segs[i+1].index)
+			if err != nil || idx < 0 {
+				return newFieldError(key, nil, nil, ErrFieldNotFound, "invalid index '%v'", segs[i+1].index)
+			}
+
+			if idx > MaxURLValuesIndex {
+				return newFieldError(key, nil, nil, ErrFieldNotFound,
+					"index '%v' exceeds maximum index %d", idx, MaxURLValuesIndex)
+			}
+
+			elems, _ := m[seg.name].([]interface{})
+			for len(elems) <= idx {
+				elems = append(elems, nil)
+			}
+			m[seg.name] = elems
+
+			i++
+			if i == len(segs)-1 {
+				elems[idx] = v
+				return nil
+			}
+
+			nm, ok := elems[idx].(map[string]interface{})
+			if !ok {
+				nm = map[string]interface{}{}
+				elems[idx] = nm
+			}
+			m = nm
+			continue
+		}
+
+		if i == len(segs)-1 {
+			m[seg.name] = v
+			return nil
+		}
+
+		nm, ok := m[seg.name].(map[string]interface{})
+		if !ok {
+			nm = map[string]interface{}{}
+			m[seg.name] = nm
+		}
+		m = nm
+	}
+
+	return nil
+}
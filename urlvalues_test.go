@@ -0,0 +1,122 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+)
+
+type sampleURLItem struct {
+	ID  int    `model:"id"`
+	Tag string `model:"tag,omitempty"`
+}
+
+type sampleURLFilter struct {
+	Query    string          `model:"q"`
+	Page     int             `model:"page"`
+	Active   bool            `model:"active"`
+	Tags     []string        `model:"tags"`
+	Sizes    []int           `model:"sizes"`
+	Since    time.Time       `model:"since"`
+	Timeout  time.Duration   `model:"timeout"`
+	Limit    *int            `model:"limit"`
+	Owner    sampleURLItem   `model:"owner"`
+	Items    []sampleURLItem `model:"items"`
+	Internal string          `model:"-"`
+}
+
+func TestToURLValues(t *testing.T) {
+	src := sampleURLFilter{
+		Query:   "go model",
+		Page:    2,
+		Active:  true,
+		Tags:    []string{"go", "reflect"},
+		Since:   time.Date(2017, 1, 2, 15, 4, 5, 0, time.UTC),
+		Timeout: 30 * time.Second,
+		Owner:   sampleURLItem{ID: 1},
+		Items:   []sampleURLItem{{ID: 101, Tag: "a"}, {ID: 102}},
+	}
+
+	values, err := ToURLValues(src)
+	assertError(t, err)
+	assertEqual(t, "active=true&items%5B0%5D.id=101&items%5B0%5D.tag=a&items%5B1%5D.id=102&owner.id=1&page=2&"+
+		"q=go+model&since=2017-01-02T15%3A04%3A05Z&tags=go&tags=reflect&timeout=30s", values.Encode())
+
+	// round trip
+	var dst sampleURLFilter
+	errs := FromURLValues(&dst, values)
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, "go model", dst.Query)
+	assertEqual(t, 2, dst.Page)
+	assertEqual(t, true, dst.Active)
+	assertEqual(t, []string{"go", "reflect"}, dst.Tags)
+	assertEqual(t, true, dst.Since.Equal(src.Since))
+	assertEqual(t, true, dst.Timeout == src.Timeout)
+	assertEqual(t, true, dst.Limit == nil)
+	assertEqual(t, true, dst.Owner == src.Owner)
+	assertEqual(t, 2, len(dst.Items))
+	assertEqual(t, true, dst.Items[0] == src.Items[0])
+	assertEqual(t, true, dst.Items[1] == src.Items[1])
+
+	// zero value nested struct is dot-path keyed too
+	values, err = ToURLValues(sampleURLFilter{Query: "go"})
+	assertError(t, err)
+	assertEqual(t, "0", values.Get("owner.id"))
+	assertEqual(t, "", values.Get("owner"))
+
+	_, err = ToURLValues(nil)
+	assertEqual(t, true, errors.Is(err, ErrNilInput))
+}
+
+func TestFromURLValues(t *testing.T) {
+	values := url.Values{
+		"q":           {"go"},
+		"page":        {""},
+		"tags":        {"go"},
+		"sizes":       {"1", "2"},
+		"limit":       {"10"},
+		"Internal":    {"internal"},
+		"items[0].id": {"101"},
+		"items[2].id": {"103"},
+		"owner..id":   {"1"},
+	}
+
+	var dst sampleURLFilter
+	errs := FromURLValues(&dst, values)
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, "go", dst.Query)
+	assertEqual(t, 0, dst.Page)
+	assertEqual(t, []string{"go"}, dst.Tags)
+	assertEqual(t, []int{1, 2}, dst.Sizes)
+	assertEqual(t, 10, *dst.Limit)
+	assertEqual(t, "", dst.Internal)
+	assertEqual(t, 3, len(dst.Items))
+	assertEqual(t, 101, dst.Items[0].ID)
+	assertEqual(t, 0, dst.Items[1].ID)
+	assertEqual(t, 103, dst.Items[2].ID)
+	assertEqual(t, 0, dst.Owner.ID)
+
+	// lone element key grows the slice
+	dst = sampleURLFilter{}
+	errs = FromURLValues(&dst, url.Values{"items[1].tag": {"b"}})
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, 2, len(dst.Items))
+	assertEqual(t, "b", dst.Items[1].Tag)
+
+	errs = FromURLValues(&dst, url.Values{"items[1001].id": {"1"}, "items[x].id": {"1"}})
+	assertEqual(t, 2, len(errs))
+	assertEqual(t, "Field: 'items[1001].id', index '1001' exceeds maximum index 1000", errs[0].Error())
+	assertEqual(t, "Field: 'items[x].id', invalid index 'x'", errs[1].Error())
+	assertEqual(t, true, errors.Is(errs[0], ErrFieldNotFound))
+
+	errs = FromURLValues(&dst, url.Values{"page": {"two"}})
+	assertEqual(t, 1, len(errs))
+
+	errs = FromURLValues(dst, values)
+	assertEqual(t, true, errors.Is(errs[0], ErrNotPointer))
+}
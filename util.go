@@ -5,19 +5,119 @@
 package model
 
 import (
+	"context"
 	"errors"
-	"fmt"
 	"reflect"
 )
 
 var errFieldNotExists = errors.New("Field does not exists")
 
+// zeroer is implemented by types which knows it's zero value, for eg.: `time.Time`.
+type zeroer interface {
+	IsZero() bool
+}
+
+var typeOfZeroer = reflect.TypeOf((*zeroer)(nil)).Elem()
+
+// isFieldZero method reports the field is zero value, `IsZero() bool` method of the
+// field type is used if implemented, for eg.: `time.Time`. Otherwise it's evaluated
+// without allocation and floating point negative zero is considered as zero, same as
+// "==" comparison. Non-nil pointer is not a zero value.
 func isFieldZero(f reflect.Value) bool {
-	// zero value of the given field
-	// For example: reflect.Zero(reflect.TypeOf(42)) returns a Value with Kind Int and value 0
-	zero := reflect.Zero(f.Type()).Interface()
+	if !isPtr(f) && !isInterface(f) {
+		if zero, ok := zeroMethod(f); ok {
+			return zero
+		}
+	}
+
+	switch f.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return f.Float() == 0
+	case reflect.Complex64, reflect.Complex128:
+		return f.Complex() == 0
+	case reflect.Array:
+		for i := 0; i < f.Len(); i++ {
+			if !isFieldZero(f.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Struct:
+		for i := 0; i < f.NumField(); i++ {
+			if !isFieldZero(f.Field(i)) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return f.IsZero()
+}
+
+// structOf method returns the struct value held by interface or pointer.
+func structOf(v reflect.Value) reflect.Value {
+	if isInterface(v) {
+		v = v.Elem()
+	}
+
+	return indirect(v)
+}
+
+// isZeroValue method reports zero value using `IsZero() bool` method of the
+// value if implemented, otherwise Go zero value of the type. It's used for
+// 'omitzero' option.
+func isZeroValue(f reflect.Value) bool {
+	if (isPtr(f) || isInterface(f)) && f.IsNil() {
+		return true
+	}
+
+	if isInterface(f) {
+		f = f.Elem()
+	}
+
+	if zero, ok := zeroMethod(f); ok {
+		return zero
+	}
 
-	return reflect.DeepEqual(f.Interface(), zero)
+	return f.IsZero()
+}
+
+// isStructFieldZero method reports the struct field value is zero, `IsZero() bool`
+// method of the type is used if implemented, otherwise the fields are evaluated
+// as per options.
+func isStructFieldZero(fv reflect.Value, o *options) bool {
+	if zero, ok := zeroMethod(fv); ok {
+		return zero
+	}
+
+	return structZero(structOf(fv), nil, o)
+}
+
+// zeroMethod method reports zero value using registered `ZeroChecker` of the type
+// or `IsZero() bool` method of the value, pointer receiver method is used too. ok
+// is false if neither is applicable or value is not accessible.
+func zeroMethod(f reflect.Value) (zero bool, ok bool) {
+	if !f.IsValid() || !f.CanInterface() {
+		return false, false
+	}
+
+	t := f.Type()
+	if checker := zeroCheckerOf(t); checker != nil {
+		return checker(f), true
+	}
+	if t.Implements(typeOfZeroer) {
+		if (isPtr(f) || isInterface(f)) && f.IsNil() {
+			return true, true
+		}
+
+		return f.Interface().(zeroer).IsZero(), true
+	}
+
+	if t.Kind() != reflect.Ptr && reflect.PtrTo(t).Implements(typeOfZeroer) {
+		return addressable(f).Addr().Interface().(zeroer).IsZero(), true
+	}
+
+	return false, false
 }
 
 func isNoTraverseType(v reflect.Value) bool {
@@ -27,25 +127,77 @@ func isNoTraverseType(v reflect.Value) bool {
 
 	t := deepTypeOf(v)
 
+	registryMu.RLock()
 	_, found := noTraverseTypeList[t]
+	registryMu.RUnlock()
+
 	return found
 }
 
-func validateCopyField(f reflect.StructField, sfv, dfv reflect.Value) error {
+// validateCopyInput method validates the source and destination of copy.
+func validateCopyInput(dst, src interface{}) error {
+	if src == nil || dst == nil {
+		return newError(ErrNilInput, "Source or Destination is nil")
+	}
+
+	if !isStruct(valueOf(src)) || !isStruct(valueOf(dst)) {
+		return newError(ErrNotStruct, "Source or Destination is not a struct")
+	}
+
+	if !isPtr(valueOf(dst)) {
+		return newError(ErrNotPointer, "Destination struct is not a pointer")
+	}
+
+	if IsZero(src) {
+		return newError(ErrEmptyStruct, "Source struct is empty")
+	}
+
+	return nil
+}
+
+func validateCopyField(f reflect.StructField, sfv, dfv reflect.Value, o *options) error {
 	// check dst field is exists, if not valid move on
 	if !dfv.IsValid() {
 		return errFieldNotExists
 		//return fmt.Errorf("Field does not exists in dst", f.Name)
 	}
 
-	if conversionExists(sfv.Type(), dfv.Type()) {
+	if o.conversionExists(sfv.Type(), dfv.Type()) {
+		return nil
+	}
+
+	if isPtrAdaptable(sfv.Type(), dfv.Type()) {
+		return nil
+	}
+
+	if o.isConvertible(sfv.Type(), dfv.Type()) {
+		return nil
+	}
+
+	// database value is copied via it's valuer or scanner
+	if isSQLConvertible(sfv.Type(), dfv.Type()) {
+		return nil
+	}
+
+	// duration is converted from and into string
+	if isDurationConvertible(sfv.Type(), dfv.Type()) {
+		return nil
+	}
+
+	// UUID is converted from and into string
+	if isUUIDConvertible(sfv.Type(), dfv.Type()) {
+		return nil
+	}
+
+	// source struct maps itself into destination struct
+	if _, ok := copierOf(sfv); ok && isStruct(sfv) && indirectType(dfv.Type()).Kind() == reflect.Struct {
 		return nil
 	}
 
 	// check kind of src and dst, if doesn't match move on
 	if (sfv.Kind() != dfv.Kind()) && !isInterface(dfv) {
-		return fmt.Errorf("Field: '%v', src [%v] & dst [%v] kind didn't match",
-			f.Name,
+		return newFieldError(f.Name, sfv.Type(), dfv.Type(), ErrTypeMismatch,
+			"src [%v] & dst [%v] kind didn't match",
 			sfv.Kind(),
 			dfv.Kind(),
 		)
@@ -55,13 +207,17 @@ func validateCopyField(f reflect.StructField, sfv, dfv reflect.Value) error {
 	sfvt := deepTypeOf(sfv)
 	dfvt := deepTypeOf(dfv)
 
-	if (sfvt.Kind() == reflect.Slice || sfvt.Kind() == reflect.Map) && sfvt.Kind() == dfvt.Kind() && conversionExists(sfvt.Elem(), dfvt.Elem()) {
+	if o.elemConversionExists(sfvt, dfvt) {
+		return nil
+	}
+
+	if o.isConvertible(sfvt, dfvt) {
 		return nil
 	}
 
 	if (sfvt != dfvt) && !isInterface(dfv) {
-		return fmt.Errorf("Field: '%v', src [%v] & dst [%v] type didn't match",
-			f.Name,
+		return newFieldError(f.Name, sfv.Type(), dfv.Type(), ErrTypeMismatch,
+			"src [%v] & dst [%v] type didn't match",
 			sfvt,
 			dfvt,
 		)
@@ -70,46 +226,129 @@ func validateCopyField(f reflect.StructField, sfv, dfv reflect.Value) error {
 	return nil
 }
 
-func modelFields(v reflect.Value) []reflect.StructField {
-	v = indirect(v)
-	t := v.Type()
-
-	var fs []reflect.StructField
+// isPtrAdaptable method reports the source and destination are pointer and
+// value of the same type, for eg.: `string` and `*string`.
+func isPtrAdaptable(st, dt reflect.Type) bool {
+	return (dt.Kind() == reflect.Ptr && dt.Elem() == st) ||
+		(st.Kind() == reflect.Ptr && st.Elem() == dt)
+}
 
-	for i := 0; i < t.NumField(); i++ {
-		f := t.Field(i)
+// elemConversionExists method reports the converter exists for the elements of
+// slice, array or map at any nesting level.
+func (o *options) elemConversionExists(st, dt reflect.Type) bool {
+	if st.Kind() != dt.Kind() {
+		return false
+	}
 
-		// Only exported fields of a struct can be accessed.
-		// So, non-exported fields will be ignored
-		if f.PkgPath == "" {
-			fs = append(fs, f)
+	switch st.Kind() {
+	case reflect.Array:
+		if st.Len() != dt.Len() {
+			return false
+		}
+	case reflect.Map:
+		if st.Key() != dt.Key() {
+			return false
 		}
+	case reflect.Slice:
+	default:
+		return false
+	}
+
+	return o.conversionExists(st.Elem(), dt.Elem()) || o.elemConversionExists(st.Elem(), dt.Elem())
+}
+
+// isConvertible method reports the source type is convertible into destination
+// type as per auto, numeric and weak typing options, slice and map types are
+// reported by it's elements.
+func (o *options) isConvertible(st, dt reflect.Type) bool {
+	if !o.autoConvert && !o.numericConvert && !o.weakTyping {
+		return false
+	}
+
+	// pointer conversion shares the value, so it's not applied
+	if st == dt || isInterfaceType(dt) || st.Kind() == reflect.Ptr || dt.Kind() == reflect.Ptr {
+		return false
+	}
+
+	if st.Kind() == dt.Kind() && st.Kind() == reflect.Slice && st != typeOfBytes {
+		return st.Elem() == dt.Elem() || o.isConvertible(st.Elem(), dt.Elem())
 	}
 
-	return fs
+	if st.Kind() == dt.Kind() && st.Kind() == reflect.Array && st.Len() == dt.Len() {
+		return st.Elem() == dt.Elem() || o.isConvertible(st.Elem(), dt.Elem())
+	}
+
+	if st.Kind() == dt.Kind() && st.Kind() == reflect.Map && st.Key() == dt.Key() {
+		return st.Elem() == dt.Elem() || o.isConvertible(st.Elem(), dt.Elem())
+	}
+
+	if isNumberKind(st.Kind()) && isNumberKind(dt.Kind()) {
+		return true
+	}
+
+	if o.weakTyping && isWeakKind(st.Kind()) && isWeakKind(dt.Kind()) {
+		return true
+	}
+
+	if !o.autoConvert {
+		return false
+	}
+
+	// integer to string is a rune conversion in Go
+	if isIntegerKind(st.Kind()) && dt.Kind() == reflect.String {
+		return false
+	}
+
+	return st.ConvertibleTo(dt)
+}
+
+// convert method converts the value into destination type, number is
+// converted with overflow and precision loss detection and other scalar
+// values are coerced.
+func convert(v reflect.Value, dt reflect.Type) (reflect.Value, error) {
+	if isWeakKind(v.Kind()) && isWeakKind(dt.Kind()) {
+		return weakConvert(v, dt)
+	}
+
+	return v.Convert(dt), nil
+}
+
+func modelFields(v reflect.Value) []reflect.StructField {
+	v = indirect(v)
+	return typeInfoOf(v.Type()).fields
 }
 
 func structValue(s interface{}) (reflect.Value, error) {
 	if s == nil {
-		return reflect.Value{}, errors.New("Invalid input <nil>")
+		return reflect.Value{}, newError(ErrNilInput, "Invalid input <nil>")
 	}
 
 	sv := indirect(valueOf(s))
 
 	if !isStruct(sv) {
-		return reflect.Value{}, errors.New("Input is not a struct")
+		return reflect.Value{}, newError(ErrNotStruct, "Input is not a struct")
 	}
 
 	return sv, nil
 }
 
-func getField(sv reflect.Value, name string) (reflect.Value, error) {
-	field := sv.FieldByName(name)
-	if !field.IsValid() {
-		return reflect.Value{}, fmt.Errorf("Field: '%v', does not exists", name)
+// destStructValue method validates the destination is a pointer of struct
+// and returns the struct value.
+func destStructValue(dst interface{}) (reflect.Value, error) {
+	if dst == nil {
+		return reflect.Value{}, newError(ErrNilInput, "Destination is nil")
 	}
 
-	return field, nil
+	dv := valueOf(dst)
+	if !isStruct(dv) {
+		return reflect.Value{}, newError(ErrNotStruct, "Destination is not a struct")
+	}
+
+	if !isPtr(dv) {
+		return reflect.Value{}, newError(ErrNotPointer, "Destination struct is not a pointer")
+	}
+
+	return indirect(dv), nil
 }
 
 func zeroOf(f reflect.Value) reflect.Value {
@@ -122,7 +361,12 @@ func zeroOf(f reflect.Value) reflect.Value {
 	}
 
 	// if not a pointer then get zero value for interface
-	return indirect(valueOf(ftz.Interface()))
+	if zv := indirect(valueOf(ftz.Interface())); zv.IsValid() {
+		return zv
+	}
+
+	// zero value of interface type is nil
+	return ftz
 }
 
 func deepTypeOf(v reflect.Value) reflect.Type {
@@ -146,6 +390,16 @@ func indirect(v reflect.Value) reflect.Value {
 	return reflect.Indirect(v)
 }
 
+// singlePtr method dereferences the multi-level pointer till single level,
+// nil pointer of any level is returned as-is.
+func singlePtr(v reflect.Value) reflect.Value {
+	for isPtr(v) && !v.IsNil() && isPtr(v.Elem()) {
+		v = v.Elem()
+	}
+
+	return v
+}
+
 func isPtr(v reflect.Value) bool {
 	return v.Kind() == reflect.Ptr
 }
@@ -174,6 +428,15 @@ func extractType(x interface{}) reflect.Type {
 }
 
 func conversionExists(srcType reflect.Type, destType reflect.Type) bool {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	if len(conditionalConverterMap[srcType][destType]) > 0 {
+		return true
+	}
+	if _, ok := contextConverterMap[srcType][destType]; ok {
+		return true
+	}
 	if _, ok := converterMap[srcType]; !ok {
 		return false
 	}
@@ -182,3 +445,150 @@ func conversionExists(srcType reflect.Type, destType reflect.Type) bool {
 	}
 	return true
 }
+
+// conditionalConverter is a `Converter` applied only if it's `Predicate` is satisfied.
+type conditionalConverter struct {
+	predicate Predicate
+	converter Converter
+}
+
+// convertValue method applies the registered converter of datatype pair on
+// given value. Conditional converters are evaluated first in the order of
+// registration, then the context-aware one and then the unconditional one.
+func convertValue(in reflect.Value, dt reflect.Type) (reflect.Value, error) {
+	return convertValueCtx(context.Background(), in, dt)
+}
+
+// convertValueCtx method is same as `convertValue` and supplies the given
+// context to context-aware converter.
+func convertValueCtx(ctx context.Context, in reflect.Value, dt reflect.Type) (reflect.Value, error) {
+	// converters are looked up under lock and invoked without it, since
+	// converter may call go-model methods
+	registryMu.RLock()
+	conditionals := conditionalConverterMap[in.Type()][dt]
+	cc, ccFound := contextConverterMap[in.Type()][dt]
+	c, found := converterMap[in.Type()][dt]
+	registryMu.RUnlock()
+
+	for _, cond := range conditionals {
+		if cond.predicate(in) {
+			return cond.converter(in)
+		}
+	}
+
+	if ccFound {
+		return cc(ctx, in)
+	}
+
+	if found {
+		return c(in)
+	}
+
+	return reflect.Value{}, newError(ErrTypeMismatch, "no converter is applicable for [%v] to [%v]", in.Type(), dt)
+}
+
+func joinPath(prefix, name string) string {
+	if isStringEmpty(prefix) {
+		return name
+	}
+
+	return prefix + "." + name
+}
+
+func isInterfaceType(t reflect.Type) bool {
+	return t.Kind() == reflect.Interface
+}
+
+// visitKey identifies the pointer during traversal, type is part of the key
+// since struct and it's first field share the same address.
+type visitKey struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+func visitKeyOf(v reflect.Value) visitKey {
+	return visitKey{ptr: v.Pointer(), typ: v.Type()}
+}
+
+// copyState holds the state of single copy process.
+type copyState struct {
+	opts *options
+
+	// visiting keeps track of source pointers which are in copy process
+	// mapped to it's destination pointer
+	visiting map[visitKey]reflect.Value
+
+	// copied keeps track of source pointers which are copied mapped to
+	// it's destination pointer, see `WithPreserveAliasing()`
+	copied map[visitKey]reflect.Value
+
+	// path is the field path in copy process, it's traced only if needed
+	path string
+
+	// aborted is true if any of the conversion is aborted due to context
+	aborted bool
+
+	// depth is the nested struct level in copy process, see `WithMaxDepth()`
+	depth int
+}
+
+// deeper method moves the copy state to the next nested struct level for the
+// given field, returned func restores the previous one. Embedded struct stays
+// at the same level.
+func (cs *copyState) deeper(f reflect.StructField) func() {
+	if f.Anonymous {
+		return func() {}
+	}
+
+	cs.depth++
+	return func() { cs.depth-- }
+}
+
+// stopped method reports the copy has to be stopped, since the error occurred
+// in fail fast mode or the errors are beyond the limit of `WithMaxErrors()`.
+func (cs *copyState) stopped(errs []error) bool {
+	if cs.opts.failFast {
+		return len(errs) > 0
+	}
+
+	return cs.opts.maxErrors > 0 && len(errs) > cs.opts.maxErrors
+}
+
+// stoppedErrors method returns the errors of stopped copy, only the first
+// error is returned in fail fast mode.
+func (cs *copyState) stoppedErrors(errs []error) []error {
+	if cs.opts.failFast {
+		return errs[:1]
+	}
+
+	return errs
+}
+
+func newCopyState(o *options) *copyState {
+	cs := &copyState{
+		opts:     o,
+		visiting: map[visitKey]reflect.Value{},
+	}
+
+	if o.preserveAliasing {
+		cs.copied = map[visitKey]reflect.Value{}
+	}
+
+	return cs
+}
+
+// copiedAs method records the destination pointer of copied source pointer,
+// if aliasing is preserved.
+func (cs *copyState) copiedAs(key visitKey, v reflect.Value) {
+	if cs.copied != nil {
+		cs.copied[key] = v
+	}
+}
+
+// withMask method switches the field mask of copy state, returned func
+// restores the previous one.
+func (cs *copyState) withMask(fm *fieldMask) func() {
+	prev := cs.opts
+	cs.opts = prev.withMask(fm)
+	return func() { cs.opts = prev }
+}
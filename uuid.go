@@ -0,0 +1,41 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+)
+
+// isUUIDType method reports the type is UUID type, it's detected by shape same as
+// `github.com/google/uuid` and `github.com/gofrs/uuid`; 16 bytes array which
+// implements `fmt.Stringer` and `encoding.TextUnmarshaler` by pointer receiver.
+// So the library need not depend on any UUID package.
+func isUUIDType(t reflect.Type) bool {
+	return t.Kind() == reflect.Array && t.Len() == 16 && t.Elem().Kind() == reflect.Uint8 &&
+		t.Implements(typeOfStringer) && reflect.PtrTo(t).Implements(typeOfTextUnmarshaler)
+}
+
+// isUUIDConvertible method reports the source and destination type are UUID
+// and string, UUID string is in it's canonical form.
+func isUUIDConvertible(st, dt reflect.Type) bool {
+	return (isUUIDType(st) && dt.Kind() == reflect.String) ||
+		(st.Kind() == reflect.String && isUUIDType(dt))
+}
+
+// convertUUID method converts the UUID into string and vice versa.
+func convertUUID(v reflect.Value, dt reflect.Type) (reflect.Value, error) {
+	if isUUIDType(v.Type()) {
+		return valueOf(v.Interface().(fmt.Stringer).String()).Convert(dt), nil
+	}
+
+	nv := reflect.New(dt)
+	if err := nv.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(v.String())); err != nil {
+		return reflect.Value{}, err
+	}
+
+	return nv.Elem(), nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"encoding/hex"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// sampleUUID has the same shape of `github.com/google/uuid` UUID type.
+type sampleUUID [16]byte
+
+func (u sampleUUID) String() string {
+	s := hex.EncodeToString(u[:])
+	return s[:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:]
+}
+
+func (u *sampleUUID) UnmarshalText(text []byte) error {
+	b, err := hex.DecodeString(strings.Replace(string(text), "-", "", -1))
+	if err != nil || len(b) != 16 {
+		return errors.New("invalid UUID format")
+	}
+
+	copy(u[:], b)
+	return nil
+}
+
+func TestIsUUIDType(t *testing.T) {
+	assertEqual(t, true, isUUIDType(reflect.TypeOf(sampleUUID{})))
+	assertEqual(t, false, isUUIDType(reflect.TypeOf([16]byte{})))
+	assertEqual(t, false, isUUIDType(reflect.TypeOf("")))
+}
+
+func TestCopyUUID(t *testing.T) {
+	type User struct {
+		ID      sampleUUID
+		OrgID   sampleUUID
+		Name    string
+		Parent  sampleUUID
+		Manager string
+	}
+
+	type UserDto struct {
+		ID      string
+		OrgID   sampleUUID
+		Name    string
+		Parent  string
+		Manager sampleUUID
+	}
+
+	id := "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+	var uid sampleUUID
+	assertError(t, uid.UnmarshalText([]byte(id)))
+
+	var dto UserDto
+	errs := Copy(&dto, User{ID: uid, OrgID: uid, Name: "jeeva", Manager: id})
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, id, dto.ID)
+	assertEqual(t, true, dto.OrgID == uid)
+	assertEqual(t, "", dto.Parent)
+	assertEqual(t, true, dto.Manager == uid)
+
+	var user User
+	errs = Copy(&user, dto)
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, true, user.ID == uid)
+	assertEqual(t, id, user.Manager)
+
+	errs = Copy(&user, UserDto{ID: "not-a-uuid"})
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, "Field: 'ID', invalid UUID format", errs[0].Error())
+}
+
+func TestMapUUID(t *testing.T) {
+	type User struct {
+		ID  sampleUUID
+		IDs []sampleUUID
+	}
+
+	var uid sampleUUID
+	assertError(t, uid.UnmarshalText([]byte("6ba7b810-9dad-11d1-80b4-00c04fd430c8")))
+
+	m, err := Map(User{ID: uid, IDs: []sampleUUID{uid}})
+	assertError(t, err)
+	assertEqual(t, true, m["ID"].(sampleUUID) == uid)
+	assertEqual(t, true, m["IDs"].([]sampleUUID)[0] == uid)
+
+	var user User
+	errs := FromMap(&user, map[string]interface{}{
+		"ID":  "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"IDs": []interface{}{"6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+	})
+	assertEqual(t, 0, len(errs))
+	assertEqual(t, true, user.ID == uid)
+	assertEqual(t, true, user.IDs[0] == uid)
+
+	errs = FromMap(&user, map[string]interface{}{"ID": "6ba7b810"})
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, "Field: 'ID', invalid UUID format", errs[0].Error())
+}
@@ -0,0 +1,107 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import "reflect"
+
+// Validate method verifies the fields tagged with "required" option in the given
+// `struct` and returns error for every required field that is zero value. Nested
+// and embedded `struct` are traversed, so the error carries the full field path.
+// 		Example:
+//
+// 		type Address struct {
+// 			City	string	`model:"city,required"`
+// 		}
+//
+// 		type Customer struct {
+// 			Name	string	`model:"name,required"`
+// 			Address	Address
+// 		}
+//
+// 		errs := model.Validate(Customer{})
+// 		fmt.Println("Errors:", errs)
+//
+// 		// Output:
+// 		Errors: [Field: 'Name', is required Field: 'Address.City', is required]
+//
+// Note: Embedded struct fields reported at the same level as represented by Go.
+// Pointer which refers back to the struct in traversal is reported as an error.
+//
+// A "model" tag with the value of "-" is ignored by library for processing.
+//
+// A "model" tag value with the option of "notraverse"; library will not traverse
+// inside the struct object. However, the field value will be evaluated whether
+// it's zero value or not for the "required" option.
+//
+func Validate(s interface{}) []error {
+	if s == nil {
+		return []error{newError(ErrNilInput, "Invalid input <nil>")}
+	}
+
+	sv, err := structValue(s)
+	if err != nil {
+		return []error{err}
+	}
+
+	// root pointer is in traversal too, so nested back reference is detected
+	visiting := map[visitKey]bool{}
+	if v := valueOf(s); isPtr(v) {
+		visiting[visitKeyOf(v)] = true
+	}
+
+	errs := doValidate(sv, "", visiting)
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+func doValidate(sv reflect.Value, prefix string, visiting map[visitKey]bool) []error {
+	var errs []error
+
+	for _, f := range modelFields(sv) {
+		fv := sv.FieldByName(f.Name)
+		tag := newTag(f.Tag.Get(TagName))
+
+		if tag.isOmitField() {
+			continue
+		}
+
+		path := prefix
+		if !f.Anonymous {
+			path = joinPath(prefix, f.Name)
+		}
+
+		if tag.isRequired() && isFieldZero(fv) {
+			errs = append(errs, newFieldError(joinPath(prefix, f.Name), fv.Type(), nil, ErrRequired, "is required"))
+			continue
+		}
+
+		// check type is in NoTraverseTypeList or has 'notraverse' tag option
+		if !isStruct(fv) || isNoTraverseType(fv) || tag.isNoTraverse() {
+			continue
+		}
+
+		pv := valueOf(fv.Interface())
+		if !isPtr(pv) {
+			errs = append(errs, doValidate(pv, path, visiting)...)
+			continue
+		}
+
+		// pointer which is in traversal cannot be validated again
+		key := visitKeyOf(pv)
+		if visiting[key] {
+			errs = append(errs, newFieldError(joinPath(prefix, f.Name), fv.Type(), nil, ErrCycle, "cycle detected"))
+			continue
+		}
+
+		visiting[key] = true
+		errs = append(errs, doValidate(pv.Elem(), path, visiting)...)
+		delete(visiting, key)
+	}
+
+	return errs
+}
@@ -0,0 +1,100 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestValidateRequiredFields(t *testing.T) {
+	type Address struct {
+		City    string `model:"city,required"`
+		Country string
+	}
+
+	type Base struct {
+		ID int `model:"id,required"`
+	}
+
+	type Customer struct {
+		Base
+		Name      string `model:"name,required"`
+		Email     string `model:"email,required"`
+		Address   Address
+		ShipTo    *Address `model:",required"`
+		BillTo    *Address
+		CreatedAt time.Time `model:",required"`
+		Skipped   string    `model:"-"`
+		Archive   Address   `model:",notraverse"`
+		Tags      []string  `model:",required"`
+		Extra     *time.Time
+	}
+
+	errs := Validate(Customer{Email: "jeeva@myjeeva.com"})
+	assertEqual(t, 6, len(errs))
+	assertEqual(t, "Field: 'ID', is required", errs[0].Error())
+	assertEqual(t, "Field: 'Name', is required", errs[1].Error())
+	assertEqual(t, "Field: 'Address.City', is required", errs[2].Error())
+	assertEqual(t, "Field: 'ShipTo', is required", errs[3].Error())
+	assertEqual(t, "Field: 'CreatedAt', is required", errs[4].Error())
+	assertEqual(t, "Field: 'Tags', is required", errs[5].Error())
+
+	// nested pointer is traversed
+	errs = Validate(&Customer{
+		Base:      Base{ID: 1},
+		Name:      "Jeeva",
+		Email:     "jeeva@myjeeva.com",
+		Address:   Address{City: "Chennai"},
+		ShipTo:    &Address{Country: "India"},
+		CreatedAt: time.Now(),
+		Tags:      []string{"vip"},
+	})
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, "Field: 'ShipTo.City', is required", errs[0].Error())
+
+	errs = Validate(&Customer{
+		Base:      Base{ID: 1},
+		Name:      "Jeeva",
+		Email:     "jeeva@myjeeva.com",
+		Address:   Address{City: "Chennai"},
+		ShipTo:    &Address{City: "Chennai"},
+		CreatedAt: time.Now(),
+		Tags:      []string{"vip"},
+	})
+	assertEqual(t, true, errs == nil)
+}
+
+func TestValidateInput(t *testing.T) {
+	errs := Validate(nil)
+	assertEqual(t, "Invalid input <nil>", errs[0].Error())
+
+	errs = Validate(10)
+	assertEqual(t, "Input is not a struct", errs[0].Error())
+}
+
+func TestValidateCycle(t *testing.T) {
+	type Node struct {
+		Name string `model:"name,required"`
+		Next *Node
+	}
+
+	n := &Node{Name: "first"}
+	n.Next = &Node{Next: n}
+
+	errs := Validate(n)
+	assertEqual(t, 2, len(errs))
+	assertEqual(t, "Field: 'Next.Name', is required", errs[0].Error())
+	assertEqual(t, "Field: 'Next.Next', cycle detected", errs[1].Error())
+
+	// same pointer in sibling fields is not a cycle
+	type Pair struct {
+		Left  *Node
+		Right *Node
+	}
+
+	leaf := &Node{Name: "leaf"}
+	assertEqual(t, true, Validate(Pair{Left: leaf, Right: leaf}) == nil)
+}
@@ -0,0 +1,197 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// VerifyCopy method verifies the destination `struct` reflects the source `struct`
+// as per the copy rules of `Copy()` method, it's typically used after the copy to
+// catch converter bugs and silent skips. Method returns the field paths (see `Get()`
+// method) where destination differs from source, otherwise nil. If input is not
+// a struct, method returns nil.
+// 		Example:
+//
+// 		errs := model.Copy(&dst, src)
+// 		if paths := model.VerifyCopy(&dst, src); len(paths) > 0 {
+// 			t.Errorf("Copy mismatch: %v", paths)
+// 		}
+//
+// Note:
+// [1] Fields which are not copyable by the rules (field does not exists in destination,
+// kind/type didn't match) are not verified.
+// [2] Field having custom `Converter` is verified against the converted value.
+// [3] Value holding handle is not verified, see `AddHandleType()` method.
+//
+// A "model" tag with the value of "-" is ignored by library for processing.
+//
+// A "model" tag value with the option of "omitempty"; zero source value is not verified.
+//
+// A "model" tag value with the option of "notraverse"; library will not traverse
+// inside the struct object, however the field value is verified as a whole.
+//
+func VerifyCopy(dst, src interface{}) []string {
+	sv, err := structValue(src)
+	if err != nil {
+		return nil
+	}
+
+	dv, err := structValue(dst)
+	if err != nil {
+		return nil
+	}
+
+	vr := &verifier{visiting: map[visitKey]bool{}}
+	vr.verifyStruct(dv, sv, "")
+	return vr.paths
+}
+
+type verifier struct {
+	visiting map[visitKey]bool
+	paths    []string
+}
+
+func (vr *verifier) mismatch(path string) {
+	vr.paths = append(vr.paths, path)
+}
+
+func (vr *verifier) verifyStruct(dv, sv reflect.Value, prefix string) {
+	for _, f := range modelFields(sv) {
+		sfv := sv.FieldByName(f.Name)
+		dfv := dv.FieldByName(f.Name)
+		tag := newTag(f.Tag.Get(TagName))
+
+		if tag.isOmitField() || isHandle(sfv) || (tag.isOmitZero() && isZeroValue(sfv)) {
+			continue
+		}
+
+		// field is not copyable by the rules
+		if validateCopyField(f, sfv, dfv, &options{}) != nil {
+			continue
+		}
+
+		noTraverse := (isNoTraverseType(sfv) || tag.isNoTraverse())
+		path := joinPath(prefix, f.Name)
+
+		var isVal bool
+		if isStruct(sfv) && !noTraverse {
+			isVal = !isStructFieldZero(sfv, nil)
+		} else {
+			isVal = !isFieldZero(sfv)
+		}
+
+		if !isVal {
+			if !tag.isOmitEmpty() && !isFieldZero(dfv) {
+				vr.mismatch(path)
+			}
+			continue
+		}
+
+		// embedded struct fields are verified at embedded level
+		if f.Anonymous && isStruct(sfv) && !noTraverse {
+			path = prefix
+		}
+
+		vr.verifyVal(dfv, sfv, path, noTraverse)
+	}
+}
+
+func (vr *verifier) verifyVal(dv, sv reflect.Value, path string, notraverse bool) {
+	if conversionExists(sv.Type(), dv.Type()) && !notraverse {
+		cv, err := convertValue(sv, dv.Type())
+		if err != nil || !reflect.DeepEqual(cv.Interface(), dv.Interface()) {
+			vr.mismatch(path)
+		}
+		return
+	}
+
+	if _, found := handlePolicyOf(sv.Type()); found {
+		return
+	}
+
+	for isPtr(sv) || isInterface(sv) {
+		if sv.IsNil() {
+			if !isFieldZero(dv) {
+				vr.mismatch(path)
+			}
+			return
+		}
+
+		if isPtr(sv) {
+			key := visitKeyOf(sv)
+			if vr.visiting[key] {
+				return
+			}
+
+			vr.visiting[key] = true
+			defer delete(vr.visiting, key)
+		}
+
+		sv = sv.Elem()
+	}
+
+	for isPtr(dv) || isInterface(dv) {
+		if dv.IsNil() {
+			vr.mismatch(path)
+			return
+		}
+		dv = dv.Elem()
+	}
+
+	switch sv.Kind() {
+	case reflect.Struct:
+		if notraverse || isNoTraverseType(sv) {
+			break
+		}
+
+		if dv.Kind() != reflect.Struct {
+			vr.mismatch(path)
+			return
+		}
+
+		vr.verifyStruct(dv, sv, path)
+		return
+	case reflect.Slice, reflect.Array:
+		if sv.Type() == typeOfBytes {
+			break
+		}
+
+		if (dv.Kind() != reflect.Slice && dv.Kind() != reflect.Array) || dv.Len() != sv.Len() {
+			vr.mismatch(path)
+			return
+		}
+
+		for i := 0; i < sv.Len(); i++ {
+			ev := sv.Index(i)
+			vr.verifyVal(dv.Index(i), ev, fmt.Sprintf("%v[%d]", path, i), isNoTraverseType(ev))
+		}
+		return
+	case reflect.Map:
+		if dv.Kind() != reflect.Map || dv.Len() != sv.Len() {
+			vr.mismatch(path)
+			return
+		}
+
+		for _, k := range sortedMapKeys(sv) {
+			ev := sv.MapIndex(k)
+			kpath := fmt.Sprintf("%v[%v]", path, k.Interface())
+
+			dev := dv.MapIndex(k)
+			if !dev.IsValid() {
+				vr.mismatch(kpath)
+				continue
+			}
+
+			vr.verifyVal(dev, ev, kpath, isNoTraverseType(ev))
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(sv.Interface(), dv.Interface()) {
+		vr.mismatch(path)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestVerifyCopy(t *testing.T) {
+	type Item struct {
+		ID   int
+		Name string
+	}
+
+	type Audit struct {
+		CreatedBy string
+	}
+
+	type SampleStruct struct {
+		Audit
+		Name      string
+		Note      string `model:",omitempty"`
+		Count     int    `model:"-"`
+		Owner     *Item
+		Items     []Item
+		Meta      map[string]interface{}
+		CreatedAt time.Time
+	}
+
+	src := SampleStruct{
+		Audit:     Audit{CreatedBy: "jeeva"},
+		Name:      "go-model",
+		Count:     10,
+		Owner:     &Item{ID: 1, Name: "owner"},
+		Items:     []Item{{ID: 1, Name: "one"}, {ID: 2, Name: "two"}},
+		Meta:      map[string]interface{}{"tags": []string{"a", "b"}},
+		CreatedAt: time.Now(),
+	}
+
+	dst := SampleStruct{Note: "existing"}
+	errs := Copy(&dst, src)
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, true, VerifyCopy(&dst, src) == nil)
+
+	dst.CreatedBy = "admin"
+	dst.Owner.Name = "changed"
+	dst.Items[1].ID = 3
+	dst.Meta["tags"] = []string{"a"}
+	dst.Note = ""
+	assertEqual(t, []string{"CreatedBy", "Owner.Name", "Items[1].ID", "Meta[tags]"}, VerifyCopy(&dst, src))
+
+	dst.Items = dst.Items[:1]
+	dst.Owner = nil
+	assertEqual(t, []string{"CreatedBy", "Owner", "Items", "Meta[tags]"}, VerifyCopy(dst, &src))
+
+	// zero source value is expected as zero in destination
+	dst = SampleStruct{Name: "existing"}
+	assertEqual(t, []string{"Name"}, VerifyCopy(&dst, SampleStruct{}))
+
+	assertEqual(t, true, VerifyCopy(nil, src) == nil)
+	assertEqual(t, true, VerifyCopy(&dst, "not a struct") == nil)
+}
+
+func TestVerifyCopyConverter(t *testing.T) {
+	type Source struct {
+		Count string
+	}
+
+	type Destination struct {
+		Count int
+	}
+
+	AddConversion((*string)(nil), (*int)(nil), func(in reflect.Value) (reflect.Value, error) {
+		i, err := strconv.Atoi(in.String())
+		return reflect.ValueOf(i), err
+	})
+	defer RemoveConversion((*string)(nil), (*int)(nil))
+
+	src := Source{Count: "100"}
+	assertEqual(t, true, VerifyCopy(&Destination{Count: 100}, src) == nil)
+	assertEqual(t, []string{"Count"}, VerifyCopy(&Destination{Count: 10}, src))
+}
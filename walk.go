@@ -0,0 +1,150 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// SkipTraverse is used as a return value from `WalkFunc` to indicate that
+// the library should not traverse inside the current field value. It's not
+// returned as an error by `Walk()` method.
+var SkipTraverse = errors.New("skip traverse")
+
+// WalkFunc is the type of function called by `Walk()` method for each field,
+// path is the field path expression (see `Get()` method).
+type WalkFunc func(path string, f reflect.StructField, v reflect.Value) error
+
+// Walk method traverses the given `struct` and calls the fn for every exported
+// field, including the fields of nested struct and struct within slice/array/map.
+// Walk stops and returns the error if fn returns an error other than `SkipTraverse`.
+// 		Example:
+//
+// 		err := model.Walk(src, func(path string, f reflect.StructField, v reflect.Value) error {
+// 			fmt.Println(path, v.Interface())
+// 			return nil
+// 		})
+//
+// 		// Output:
+// 		Name go-model
+// 		Items []
+// 		Address.City Chennai
+//
+// Note:
+// [1] Embedded struct fields are visited at same level as represented by Go.
+// [2] Map entries are visited in the sorted order of keys.
+// [3] Pointer which is already in traversal is not visited again.
+//
+// A "model" tag with the value of "-" is ignored by library for processing.
+//
+// A "model" tag value with the option of "notraverse"; library will not traverse
+// inside the struct object, however the field itself is visited.
+//
+func Walk(s interface{}, fn WalkFunc) error {
+	sv, err := structValue(s)
+	if err != nil {
+		return err
+	}
+
+	w := &walker{fn: fn, visiting: map[visitKey]bool{}}
+	return w.walkStruct(sv, "")
+}
+
+type walker struct {
+	fn       WalkFunc
+	visiting map[visitKey]bool
+}
+
+func (w *walker) walkStruct(sv reflect.Value, prefix string) error {
+	ti := typeInfoOf(sv.Type())
+	for i, f := range ti.fields {
+		fv := sv.FieldByIndex(f.Index)
+		tag := ti.tags[i]
+
+		if tag.isOmitField() {
+			continue
+		}
+
+		path := joinPath(prefix, f.Name)
+		if err := w.fn(path, f, fv); err != nil {
+			if err == SkipTraverse {
+				continue
+			}
+
+			return err
+		}
+
+		// check type is in NoTraverseTypeList or has 'notraverse' tag option
+		if isNoTraverseType(fv) || tag.isNoTraverse() {
+			continue
+		}
+
+		// embedded struct fields are visited at embedded level
+		if f.Anonymous {
+			path = prefix
+		}
+
+		if err := w.walkVal(fv, path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *walker) walkVal(v reflect.Value, path string) error {
+	for isPtr(v) || isInterface(v) {
+		if v.IsNil() {
+			return nil
+		}
+
+		if isPtr(v) {
+			key := visitKeyOf(v)
+			if w.visiting[key] {
+				return nil
+			}
+
+			w.visiting[key] = true
+			defer delete(w.visiting, key)
+		}
+
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		if isNoTraverseType(v) {
+			return nil
+		}
+
+		return w.walkStruct(v, path)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := w.walkVal(v.Index(i), fmt.Sprintf("%v[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		for _, k := range sortedMapKeys(v) {
+			if err := w.walkVal(v.MapIndex(k), fmt.Sprintf("%v[%v]", path, k.Interface())); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func sortedMapKeys(v reflect.Value) []reflect.Value {
+	keys := v.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprintf("%v", keys[i].Interface()) < fmt.Sprintf("%v", keys[j].Interface())
+	})
+
+	return keys
+}
@@ -0,0 +1,87 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestWalk(t *testing.T) {
+	type Item struct {
+		ID int
+	}
+
+	type Base struct {
+		ID int
+	}
+
+	type Node struct {
+		Name string
+		Next *Node
+	}
+
+	type SampleStruct struct {
+		Base
+		Name      string
+		Items     []Item
+		Meta      map[string]*Item
+		Skipped   string `model:"-"`
+		Archive   Item   `model:",notraverse"`
+		CreatedAt time.Time
+		Node      *Node
+		Any       interface{}
+	}
+
+	node := &Node{Name: "first"}
+	node.Next = node
+
+	src := SampleStruct{
+		Base:  Base{ID: 1},
+		Name:  "go-model",
+		Items: []Item{{ID: 101}, {ID: 102}},
+		Meta:  map[string]*Item{"b": {ID: 202}, "a": {ID: 201}},
+		Node:  node,
+		Any:   Item{ID: 301},
+	}
+
+	var paths []string
+	err := Walk(&src, func(path string, f reflect.StructField, v reflect.Value) error {
+		paths = append(paths, path)
+		return nil
+	})
+	assertError(t, err)
+	assertEqual(t, []string{
+		"Base", "ID", "Name",
+		"Items", "Items[0].ID", "Items[1].ID",
+		"Meta", "Meta[a].ID", "Meta[b].ID",
+		"Archive", "CreatedAt",
+		"Node", "Node.Name", "Node.Next",
+		"Any", "Any.ID",
+	}, paths)
+
+	// skip traverse and stop on error
+	paths = nil
+	errStop := errors.New("stop")
+	err = Walk(src, func(path string, f reflect.StructField, v reflect.Value) error {
+		paths = append(paths, path)
+		if f.Name == "Items" {
+			return SkipTraverse
+		}
+
+		if path == "Meta[a].ID" {
+			return errStop
+		}
+
+		return nil
+	})
+	assertEqual(t, true, err == errStop)
+	assertEqual(t, []string{"Base", "ID", "Name", "Items", "Meta", "Meta[a].ID"}, paths)
+
+	err = Walk(nil, nil)
+	assertEqual(t, "Invalid input <nil>", err.Error())
+}
@@ -0,0 +1,92 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"reflect"
+	"strconv"
+)
+
+// WithWeakTyping option enables the weak typing mode for `Copy()` and `FromMap()`
+// methods, the string, bool and number values are coerced into each other. It's
+// useful when source is form values or loosely-typed JSON.
+// 		Example:
+//
+// 		errs := model.FromMap(&dst, map[string]interface{}{
+// 			"count":  "123",   // string into int field
+// 			"active": 1,       // number into bool field, non-zero is true
+// 			"code":   101,     // number into string field
+// 			"ratio":  "",      // empty string into zero value
+// 		}, model.WithWeakTyping())
+//
+// Numbers are converted same as `WithNumericConvert()` option, bool is converted
+// into number 1 or 0 and number into bool `true` if it's non-zero. String is parsed
+// into bool via `strconv.ParseBool`, empty string is zero value of any type.
+//
+func WithWeakTyping() Option {
+	return func(o *options) {
+		o.weakTyping = true
+	}
+}
+
+// isWeakKind method reports the kind is coercible in weak typing mode.
+func isWeakKind(k reflect.Kind) bool {
+	return k == reflect.String || k == reflect.Bool || isNumberKind(k)
+}
+
+// weakConvert method coerces the string, bool or number value into
+// destination type.
+func weakConvert(v reflect.Value, dt reflect.Type) (reflect.Value, error) {
+	sk, dk := v.Kind(), dt.Kind()
+	if isNumberKind(sk) && isNumberKind(dk) {
+		return convertNumber(v, dt)
+	}
+
+	nv := reflect.New(dt).Elem()
+	switch {
+	case sk == dk:
+		nv.Set(v.Convert(dt))
+	case sk == reflect.String:
+		if isStringEmpty(v.String()) {
+			return nv, nil
+		}
+
+		pv, err := parseString(v.String(), dt)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		nv.Set(pv)
+	case dk == reflect.String:
+		nv.SetString(formatScalar(v))
+	case sk == reflect.Bool:
+		var b int64
+		if v.Bool() {
+			b = 1
+		}
+		return convertNumber(valueOf(b), dt)
+	case dk == reflect.Bool:
+		nv.SetBool(!isFieldZero(v))
+	default:
+		return reflect.Value{}, newError(ErrTypeMismatch, "cannot coerce [%v] into [%v]", v.Type(), dt)
+	}
+
+	return nv, nil
+}
+
+// formatScalar method formats the bool or number value as string.
+func formatScalar(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits())
+	}
+
+	return v.String()
+}
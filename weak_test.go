@@ -0,0 +1,82 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+type weakSample struct {
+	Count   int     `model:"count"`
+	Active  bool    `model:"active"`
+	Code    string  `model:"code"`
+	Ratio   float32 `model:"ratio"`
+	Enabled bool    `model:"enabled"`
+	Limit   *uint8  `model:"limit"`
+	Sizes   []int   `model:"sizes"`
+}
+
+func TestFromMapWithWeakTyping(t *testing.T) {
+	m := map[string]interface{}{
+		"count":   "123",
+		"active":  1,
+		"code":    101,
+		"ratio":   "",
+		"enabled": "true",
+		"limit":   float64(20),
+		"sizes":   []interface{}{"1", 2.0, true},
+	}
+
+	dst := weakSample{Ratio: 1.5}
+	errs := FromMap(&dst, m, WithWeakTyping())
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, 123, dst.Count)
+	assertEqual(t, true, dst.Active)
+	assertEqual(t, "101", dst.Code)
+	assertEqual(t, float32(0), dst.Ratio)
+	assertEqual(t, true, dst.Enabled)
+	assertEqual(t, uint8(20), *dst.Limit)
+	assertEqual(t, []int{1, 2, 1}, dst.Sizes)
+
+	// invalid value
+	errs = FromMap(&weakSample{}, map[string]interface{}{"count": "one", "limit": 300}, WithWeakTyping())
+	assertEqual(t, 2, len(errs))
+
+	// without option
+	errs = FromMap(&weakSample{}, map[string]interface{}{"active": 1, "code": 101})
+	assertEqual(t, 2, len(errs))
+
+	v, errs := Construct(reflect.TypeOf(weakSample{}), map[string]interface{}{"active": "1"}, WithWeakTyping())
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, true, v.(*weakSample).Active)
+}
+
+func TestCopyWithWeakTyping(t *testing.T) {
+	type Source struct {
+		Count   string
+		Active  int
+		Code    int64
+		Ratio   bool
+		Enabled string
+		Sizes   []string
+	}
+
+	src := Source{Count: "123", Active: 2, Code: 101, Ratio: true, Enabled: "false", Sizes: []string{"3", "4"}}
+
+	dst := weakSample{}
+	errs := Copy(&dst, src, WithWeakTyping())
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, 123, dst.Count)
+	assertEqual(t, true, dst.Active)
+	assertEqual(t, "101", dst.Code)
+	assertEqual(t, float32(1), dst.Ratio)
+	assertEqual(t, false, dst.Enabled)
+	assertEqual(t, []int{3, 4}, dst.Sizes)
+
+	errs = Copy(&weakSample{}, Source{Count: "12a"}, WithWeakTyping())
+	assertEqual(t, 1, len(errs))
+	assertEqual(t, `Field: 'Count', strconv.ParseInt: parsing "12a": invalid syntax`, errs[0].Error())
+}
@@ -0,0 +1,297 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ZeroChecker is used to report the value is zero for the type, which has
+// sentinel "empty" value other than Go zero value, for eg.: -1, "N/A".
+type ZeroChecker func(v reflect.Value) bool
+
+// zeroCheckerMap keeps track of zero checkers at library level
+var zeroCheckerMap = map[reflect.Type]ZeroChecker{}
+
+// AddZeroChecker method registers the `ZeroChecker` for the Go Lang type, then
+// the field value of the type is evaluated using checker in `IsZero()`, `HasZero()`
+// methods and 'omitempty', 'omitzero' options. Checker takes precedence over
+// `IsZero() bool` method of the type. See also `RemoveZeroChecker()` method.
+// 		Example:
+//
+// 		model.AddZeroChecker(Code(0), func(v reflect.Value) bool {
+// 			return v.Int() == -1
+// 		})
+//
+// 		model.AddZeroChecker(Label(""), func(v reflect.Value) bool {
+// 			return v.String() == "" || v.String() == "N/A"
+// 		})
+//
+// Note: It's safe to call at runtime from multiple goroutines.
+//
+func AddZeroChecker(i interface{}, checker ZeroChecker) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	zeroCheckerMap[reflect.TypeOf(i)] = checker
+}
+
+// RemoveZeroChecker method is used to remove the `ZeroChecker` of Go Lang type(s).
+// See also `AddZeroChecker()` method.
+func RemoveZeroChecker(i ...interface{}) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	for _, v := range i {
+		delete(zeroCheckerMap, reflect.TypeOf(v))
+	}
+}
+
+// zeroCheckerOf method returns the registered zero checker of the type.
+func zeroCheckerOf(t reflect.Type) ZeroChecker {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	return zeroCheckerMap[t]
+}
+
+// ZeroFields method returns the field paths of all zero value fields in the
+// given `struct`, the nested struct fields are reported with path expression
+// (see `Get()` method). If input is not a struct, method returns nil.
+// 		Example:
+//
+// 		names := model.ZeroFields(req)
+// 		if len(names) > 0 {
+// 			fmt.Println("Missing fields:", names)
+// 		}
+//
+// 		// Output:
+// 		Missing fields: [Email Address.City]
+//
+// Note: Embedded struct fields are reported at same level as represented by Go.
+// Pointer which refers back to the struct in traversal is skipped.
+//
+// A "model" tag with the value of "-" is ignored by library for processing.
+//
+// A "model" tag value with the option of "notraverse"; library will not traverse
+// inside the struct object. However, the field value will be evaluated whether
+// it's zero value or not.
+//
+func ZeroFields(s interface{}) []string {
+	sv, err := structValue(s)
+	if err != nil {
+		return nil
+	}
+
+	return zeroFields(sv, "", true, rootVisiting(s))
+}
+
+// NonZeroFields method returns the field paths of all non-zero value fields in
+// the given `struct`, it's the reverse of `ZeroFields()` method. Useful for building
+// dynamic SQL UPDATE statement or partial serialization of provided values.
+// If input is not a struct, method returns nil.
+// 		Example:
+//
+// 		names := model.NonZeroFields(req)
+// 		fmt.Println("Provided fields:", names)
+//
+// 		// Output:
+// 		Provided fields: [Name Address.ZipCode]
+//
+// Note: Embedded struct fields are reported at same level as represented by Go.
+// Pointer which refers back to the struct in traversal is skipped.
+//
+// A "model" tag with the value of "-" is ignored by library for processing.
+//
+// A "model" tag value with the option of "notraverse"; library will not traverse
+// inside the struct object. However, the field value will be evaluated whether
+// it's zero value or not.
+//
+func NonZeroFields(s interface{}) []string {
+	sv, err := structValue(s)
+	if err != nil {
+		return nil
+	}
+
+	return zeroFields(sv, "", false, rootVisiting(s))
+}
+
+// SetZero method sets the zero value into given field names of the `struct`,
+// pointer, slice, map and interface fields become nil. Field name can be a path
+// expression (see `Get()` method). Handy to clear sensitive or transient fields
+// before persisting or returning a model.
+// 		Example:
+//
+// 		err := model.SetZero(&user, "Password", "Session.Token", "Meta[internal]")
+// 		fmt.Println("Error:", err)
+//
+// Note:
+// [1] SetZero continues with remaining field names on error, and returns the first one.
+// [2] Path traversing nil value is already zero, so it's not an error.
+// [3] SetZero method does not honor model tag annotations same as `Set()` method.
+//
+func SetZero(s interface{}, names ...string) error {
+	if s == nil {
+		return newError(ErrNilInput, "Invalid input <nil>")
+	}
+
+	sv := valueOf(s)
+	if !isPtr(sv) {
+		return newError(ErrNotPointer, "Destination struct is not a pointer")
+	}
+	sv = sv.Elem()
+
+	var first error
+	for _, name := range names {
+		if err := setZero(sv, name); err != nil && first == nil {
+			first = err
+		}
+	}
+
+	return first
+}
+
+func setZero(sv reflect.Value, name string) error {
+	segs, err := parsePath(name)
+	if err != nil {
+		return err
+	}
+
+	fv, err := getPath(sv, segs)
+	switch {
+	case err == errPathNilValue:
+		return nil
+	case err == errPathNotExists:
+		return newError(ErrFieldNotFound, "Field: '%v', does not exists", name)
+	case err != nil:
+		return fmt.Errorf("Field: '%v', %w", name, err)
+	}
+
+	if fv.CanSet() {
+		fv.Set(reflect.Zero(fv.Type()))
+		return nil
+	}
+
+	// map element is not addressable, it's set via map
+	if err = setPath(sv, segs, reflect.Value{}, "", assignValue); err != nil {
+		return fmt.Errorf("Field: '%v', %w", name, err)
+	}
+
+	return nil
+}
+
+// Zero method resets the given `struct` in place, all the exported fields are set
+// to zero value. Nested struct values are zeroed recursively, so the fields having
+// "-" tag retains it's value at every level. Handy for object pooling and struct
+// reuse without reallocating.
+// 		Example:
+//
+// 		req := pool.Get().(*Request)
+// 		defer func() {
+// 			_ = model.Zero(req)
+// 			pool.Put(req)
+// 		}()
+//
+// Note: Pointer, slice, map and interface fields become nil, unexported fields
+// are not modified.
+//
+// A "model" tag with the value of "-" is ignored by library for processing.
+//
+// A "model" tag value with the option of "notraverse"; library will not traverse
+// inside the struct object, the field is set to zero value as a whole.
+//
+func Zero(s interface{}) error {
+	sv, err := destStructValue(s)
+	if err != nil {
+		return err
+	}
+
+	zeroStruct(sv)
+	return nil
+}
+
+func zeroStruct(sv reflect.Value) {
+	for _, f := range modelFields(sv) {
+		fv := sv.FieldByIndex(f.Index)
+		tag := newTag(f.Tag.Get(TagName))
+
+		if tag.isOmitField() || !fv.CanSet() {
+			continue
+		}
+
+		if fv.Kind() == reflect.Struct && !isNoTraverseType(fv) && !tag.isNoTraverse() {
+			zeroStruct(fv)
+			continue
+		}
+
+		fv.Set(reflect.Zero(fv.Type()))
+	}
+}
+
+// rootVisiting method returns the visiting set with root pointer of given
+// input, since it's in traversal too.
+func rootVisiting(s interface{}) map[visitKey]bool {
+	visiting := map[visitKey]bool{}
+	if v := valueOf(s); isPtr(v) {
+		visiting[visitKeyOf(v)] = true
+	}
+
+	return visiting
+}
+
+// zeroFields method returns the field paths whose zero state matches
+// the given zero value. Pointer which is in traversal is skipped.
+func zeroFields(sv reflect.Value, prefix string, zero bool, visiting map[visitKey]bool) []string {
+	var names []string
+
+	for _, f := range modelFields(sv) {
+		fv := sv.FieldByName(f.Name)
+		tag := newTag(f.Tag.Get(TagName))
+
+		if tag.isOmitField() {
+			continue
+		}
+
+		path := joinPath(prefix, f.Name)
+
+		// embedded or nested struct, nil pointer is evaluated as a value
+		if isStruct(fv) && !isNoTraverseType(fv) && !tag.isNoTraverse() {
+			// type knows it's zero value, so not traversing inside
+			if z, ok := zeroMethod(fv); ok {
+				if z == zero {
+					names = append(names, path)
+				}
+				continue
+			}
+
+			if f.Anonymous {
+				path = prefix
+			}
+
+			pv := valueOf(fv.Interface())
+			if !isPtr(pv) {
+				names = append(names, zeroFields(pv, path, zero, visiting)...)
+				continue
+			}
+
+			key := visitKeyOf(pv)
+			if visiting[key] {
+				continue
+			}
+
+			visiting[key] = true
+			names = append(names, zeroFields(pv.Elem(), path, zero, visiting)...)
+			delete(visiting, key)
+			continue
+		}
+
+		if isFieldZero(fv) == zero {
+			names = append(names, path)
+		}
+	}
+
+	return names
+}
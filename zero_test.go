@@ -0,0 +1,276 @@
+// Copyright (c) Jeevanandam M. (https://github.com/jeevatkm).
+// go-model source code and usage is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package model
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestZeroFields(t *testing.T) {
+	type Address struct {
+		City    string
+		ZipCode string
+	}
+
+	type Audit struct {
+		CreatedBy string
+	}
+
+	type SampleStruct struct {
+		Audit
+		Name      string
+		Email     string
+		Count     int `model:"-"`
+		Address   Address
+		Billing   *Address
+		CreatedAt time.Time
+		Region    Address `model:",notraverse"`
+	}
+
+	src := SampleStruct{
+		Name:    "go-model",
+		Address: Address{ZipCode: "600001"},
+	}
+
+	names := ZeroFields(src)
+	assertEqual(t, []string{"CreatedBy", "Email", "Address.City", "Billing", "CreatedAt", "Region"}, names)
+
+	src.Billing = &Address{City: "Chennai"}
+	names = ZeroFields(&src)
+	assertEqual(t, []string{"CreatedBy", "Email", "Address.City", "Billing.ZipCode", "CreatedAt", "Region"}, names)
+
+	assertEqual(t, 0, len(ZeroFields(Address{City: "Chennai", ZipCode: "600001"})))
+	assertEqual(t, true, ZeroFields("not a struct") == nil)
+	assertEqual(t, true, ZeroFields(nil) == nil)
+}
+
+func TestNonZeroFields(t *testing.T) {
+	type Address struct {
+		City    string
+		ZipCode string
+	}
+
+	type Audit struct {
+		CreatedBy string
+	}
+
+	type SampleStruct struct {
+		Audit
+		Name    string
+		Email   string
+		Count   int `model:"-"`
+		Address Address
+		Billing *Address
+		Region  Address `model:",notraverse"`
+	}
+
+	src := SampleStruct{
+		Audit:   Audit{CreatedBy: "jeeva"},
+		Name:    "go-model",
+		Count:   10,
+		Address: Address{ZipCode: "600001"},
+		Region:  Address{City: "Chennai"},
+	}
+
+	names := NonZeroFields(src)
+	assertEqual(t, []string{"CreatedBy", "Name", "Address.ZipCode", "Region"}, names)
+
+	src.Billing = &Address{City: "Chennai"}
+	names = NonZeroFields(&src)
+	assertEqual(t, []string{"CreatedBy", "Name", "Address.ZipCode", "Billing.City", "Region"}, names)
+
+	assertEqual(t, 0, len(NonZeroFields(Address{})))
+	assertEqual(t, true, NonZeroFields("not a struct") == nil)
+}
+
+func TestZeroFieldsCycle(t *testing.T) {
+	type Node struct {
+		Name  string
+		Email string
+		Next  *Node
+	}
+
+	n := &Node{Name: "first"}
+	n.Next = &Node{Email: "second@example.com", Next: n}
+
+	assertEqual(t, []string{"Email", "Next.Name"}, ZeroFields(n))
+	assertEqual(t, []string{"Name", "Next.Email"}, NonZeroFields(n))
+
+	// value input, back reference is visited once more from the pointer
+	assertEqual(t, []string{"Email", "Next.Name", "Next.Next.Email"}, ZeroFields(*n))
+}
+
+func TestSetZero(t *testing.T) {
+	type Session struct {
+		Token   string
+		Expires time.Time
+	}
+
+	type SampleStruct struct {
+		Name     string
+		Password *string
+		Session  Session
+		Previous *Session
+		Tags     []string
+		Meta     map[string]string
+	}
+
+	password := "s3cr3t"
+	src := SampleStruct{
+		Name:     "go-model",
+		Password: &password,
+		Session:  Session{Token: "abc", Expires: time.Now()},
+		Tags:     []string{"a", "b"},
+		Meta:     map[string]string{"internal": "yes", "public": "yes"},
+	}
+
+	err := SetZero(&src, "Password", "Session.Token", "Previous.Token", "Tags[1]", "Meta[internal]")
+	assertError(t, err)
+	assertEqual(t, "go-model", src.Name)
+	assertEqual(t, true, src.Password == nil)
+	assertEqual(t, "", src.Session.Token)
+	assertEqual(t, false, src.Session.Expires.IsZero())
+	assertEqual(t, true, src.Previous == nil)
+	assertEqual(t, []string{"a", ""}, src.Tags)
+	assertEqual(t, "", src.Meta["internal"])
+	assertEqual(t, "yes", src.Meta["public"])
+
+	err = SetZero(&src, "Unknown", "Tags")
+	assertEqual(t, "Field: 'Unknown', does not exists", err.Error())
+	assertEqual(t, true, src.Tags == nil)
+
+	err = SetZero(src, "Name")
+	assertEqual(t, "Destination struct is not a pointer", err.Error())
+
+	err = SetZero(nil, "Name")
+	assertEqual(t, "Invalid input <nil>", err.Error())
+}
+
+func TestZero(t *testing.T) {
+	type Session struct {
+		Token string
+		ID    int `model:"-"`
+	}
+
+	type SampleStruct struct {
+		Session
+		Name      string
+		Count     int `model:"-"`
+		Current   Session
+		Previous  *Session
+		Tags      []string
+		CreatedAt time.Time
+		Region    Session `model:",notraverse"`
+	}
+
+	src := SampleStruct{
+		Session:   Session{Token: "abc", ID: 1},
+		Name:      "go-model",
+		Count:     10,
+		Current:   Session{Token: "def", ID: 2},
+		Previous:  &Session{Token: "ghi", ID: 3},
+		Tags:      []string{"a"},
+		CreatedAt: time.Now(),
+		Region:    Session{Token: "jkl", ID: 4},
+	}
+
+	err := Zero(&src)
+	assertError(t, err)
+	assertEqual(t, "", src.Token)
+	assertEqual(t, 1, src.Session.ID)
+	assertEqual(t, "", src.Name)
+	assertEqual(t, 10, src.Count)
+	assertEqual(t, "", src.Current.Token)
+	assertEqual(t, 2, src.Current.ID)
+	assertEqual(t, true, src.Previous == nil)
+	assertEqual(t, true, src.Tags == nil)
+	assertEqual(t, true, src.CreatedAt.IsZero())
+	assertEqual(t, 0, src.Region.ID)
+
+	err = Zero(src)
+	assertEqual(t, "Destination struct is not a pointer", err.Error())
+}
+
+func TestIsFieldZeroFastPath(t *testing.T) {
+	type Inner struct {
+		Ratio  float64
+		hidden int
+	}
+
+	negZero := math.Copysign(0, -1)
+	assertEqual(t, true, isFieldZero(valueOf(negZero)))
+	assertEqual(t, true, isFieldZero(valueOf(Inner{Ratio: negZero})))
+	assertEqual(t, false, isFieldZero(valueOf(Inner{hidden: 1})))
+	assertEqual(t, true, isFieldZero(valueOf([2]float32{})))
+	assertEqual(t, false, isFieldZero(valueOf([]int{})))
+	assertEqual(t, true, isFieldZero(valueOf(complex(0, 0))))
+	assertEqual(t, false, isFieldZero(valueOf(math.NaN())))
+}
+
+func BenchmarkIsZero(b *testing.B) {
+	src := SampleStruct{SampleSubInfo: SampleSubInfo{Name: "go-model", Year: 2016}}
+	for i := 0; i < b.N; i++ {
+		_ = IsZero(src)
+	}
+}
+
+func BenchmarkIsZeroEmpty(b *testing.B) {
+	src := SampleStruct{}
+	for i := 0; i < b.N; i++ {
+		_ = IsZero(src)
+	}
+}
+
+func BenchmarkHasZero(b *testing.B) {
+	src := SampleStruct{SampleSubInfo: SampleSubInfo{Name: "go-model", Year: 2016}}
+	for i := 0; i < b.N; i++ {
+		_ = HasZero(src)
+	}
+}
+
+type sampleCode int
+
+type sampleLabel string
+
+func TestZeroChecker(t *testing.T) {
+	AddZeroChecker(sampleCode(0), func(v reflect.Value) bool {
+		return v.Int() == -1
+	})
+	AddZeroChecker(sampleLabel(""), func(v reflect.Value) bool {
+		return v.String() == "" || v.String() == "N/A"
+	})
+	defer RemoveZeroChecker(sampleCode(0), sampleLabel(""))
+
+	type Sample struct {
+		Code  sampleCode
+		Label sampleLabel `model:",omitempty"`
+		Name  string
+	}
+
+	assertEqual(t, true, IsZero(Sample{Code: -1, Label: "N/A"}))
+	assertEqual(t, false, IsZero(Sample{Code: 0, Label: "N/A"}))
+	assertEqual(t, true, HasZero(Sample{Code: 1, Label: "N/A", Name: "go-model"}))
+	assertEqual(t, false, HasZero(Sample{Code: 1, Label: "label", Name: "go-model"}))
+	assertEqual(t, []string{"Code", "Label"}, ZeroFields(Sample{Code: -1, Label: "N/A", Name: "go-model"}))
+
+	// omitempty skips the sentinel empty value
+	dst := Sample{Label: "existing"}
+	errs := Copy(&dst, Sample{Code: 2, Label: "N/A", Name: "go-model"})
+	assertEqual(t, true, errs == nil)
+	assertEqual(t, sampleLabel("existing"), dst.Label)
+	assertEqual(t, sampleCode(2), dst.Code)
+
+	m, err := Map(Sample{Code: 2, Label: "N/A"})
+	assertError(t, err)
+	_, found := m["Label"]
+	assertEqual(t, false, found)
+
+	// removed checker is not applied
+	RemoveZeroChecker(sampleLabel(""))
+	assertEqual(t, false, HasZero(Sample{Code: 1, Label: "N/A", Name: "go-model"}))
+}